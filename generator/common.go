@@ -26,11 +26,117 @@ func HasKind(kinds []OutputKind, kind OutputKind) bool {
 	return false
 }
 
-func toCamelCase(s string) string {
-	if s == "" {
-		return ""
+// 값이 없을 수 있는 필드 (누락 키 또는 null)
+func isNullableField(field models.Field) bool {
+	return field.Optional || field.Nullable
+}
+
+// 복합 타입의 클래스명 ("[]Address" → "Address")
+func typeName(field models.Field) string {
+	_, base := splitArrayType(field.Type)
+	return base
+}
+
+// 루트를 제외한 중첩 타입 목록 (같은 타입은 한 번만, 하위 타입 먼저)
+// 타입명이 같으면 구조도 같다고 가정 (models.DedupTypes 적용 후)
+func collectComplexTypes(root models.Field) []models.Field {
+	var result []models.Field
+	seen := map[string]bool{typeName(root): true}
+	var walk func(f models.Field)
+	walk = func(f models.Field) {
+		for _, c := range f.Children {
+			if !c.IsComplex || seen[typeName(c)] {
+				continue
+			}
+			seen[typeName(c)] = true
+			walk(c)
+			result = append(result, c)
+		}
+	}
+	walk(root)
+	return result
+}
+
+// 생성할 타입 목록 (하위 타입 먼저, 마지막에 루트, 루트 묶음이면 루트 제외)
+func outputTypes(field models.Field) []models.Field {
+	types := collectComplexTypes(field)
+	if !field.Bundle {
+		types = append(types, field)
+	}
+	return types
+}
+
+// 입출력 함수를 만들 루트 타입 1개
+type outputRoot struct {
+	Name  string // 타입명 (언어별 식별자 규칙 적용 전)
+	Field models.Field
+}
+
+// 입출력 함수를 만들 루트 목록
+// 루트 묶음(OpenAPI 스키마 모음)이면 자식 스키마마다, 아니면 rootName 루트 하나
+func outputRoots(field models.Field, rootName string) []outputRoot {
+	if !field.Bundle {
+		return []outputRoot{{Name: rootName, Field: field}}
+	}
+	roots := make([]outputRoot, 0, len(field.Children))
+	for _, c := range field.Children {
+		roots = append(roots, outputRoot{Name: typeName(c), Field: c})
+	}
+	return roots
+}
+
+// 루트 묶음의 자식 스키마 중 f와 같은 타입 (collectComplexTypes 결과 중 루트로도 쓰는 타입)
+// 같은 타입이 다른 필드로 먼저 나올 수 있으므로 루트 요소 이름은 반환한 스키마 필드 기준
+func bundleRoot(root, f models.Field) (models.Field, bool) {
+	if !root.Bundle {
+		return models.Field{}, false
+	}
+	for _, c := range root.Children {
+		if c.IsComplex && typeName(c) == typeName(f) {
+			return c, true
+		}
+	}
+	return models.Field{}, false
+}
+
+// 기본 타입이 아닌 (클래스) 타입인지
+func isComplexType(t string) bool {
+	switch t {
+	case models.TypeString, models.TypeBool, models.TypeInt, models.TypeLong, models.TypeFloat, models.TypeObject:
+		return false
 	}
-	return strings.ToLower(s[:1]) + s[1:]
+	return true
+}
+
+// object 타입(값이 null뿐이었던 필드 등)이 있는지
+func usesUnknownType(types []models.Field) bool {
+	for _, t := range types {
+		for _, c := range t.Children {
+			if _, base := fieldArrayType(c); base == models.TypeObject {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 중첩 배열 타입 분해 ("[][]int" → 2, "int")
+func splitArrayType(t string) (int, string) {
+	depth := 0
+	for strings.HasPrefix(t, "[]") {
+		t = t[2:]
+		depth++
+	}
+	return depth, t
+}
+
+// 필드의 배열 차원 수와 원소 기본 타입
+func fieldArrayType(field models.Field) (int, string) {
+	depth, base := splitArrayType(field.Type)
+	if field.IsArray {
+		depth++
+	}
+	return depth, base
 }
 
 func arrayItemType(field models.Field) string {
@@ -40,3 +146,33 @@ func arrayItemType(field models.Field) string {
 	}
 	return t
 }
+
+// 원본 JSON 키 / XML 이름 (없으면 필드명 사용)
+func wireName(field models.Field) string {
+	if field.WireName != "" {
+		return field.WireName
+	}
+	return field.Name
+}
+
+// 배열을 래퍼 요소로 감쌀지 여부 (XML 입력에서 래퍼 없이 반복된 요소만 false)
+func isXMLWrappedArray(field models.Field) bool {
+	return !field.XMLUnwrapped
+}
+
+// 큰따옴표 문자열 리터럴 내용 이스케이프 (C#/Java/Go 등)
+func escapeString(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+// 래퍼 배열의 아이템 요소 이름
+func xmlItemName(field models.Field) string {
+	if field.XMLItemName != "" {
+		return field.XMLItemName
+	}
+	if depth, base := fieldArrayType(field); field.IsComplex && depth == 1 {
+		// 언어별 클래스명(예약어 회피 접미사 등)이 아닌 원래 타입명
+		return base
+	}
+	return arrayItemType(field)
+}
@@ -0,0 +1,205 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(cppGenerator{})
+}
+
+var cppKeywords = newWordSet(`alignas alignof and and_eq asm auto bitand bitor bool break case catch char
+	char8_t char16_t char32_t class compl concept const consteval constexpr constinit const_cast continue
+	co_await co_return co_yield decltype default delete do double dynamic_cast else enum explicit export extern
+	false float for friend goto if inline int long mutable namespace new noexcept not not_eq nullptr operator or
+	or_eq private protected public register reinterpret_cast requires return short signed sizeof static
+	static_assert static_cast struct switch template this thread_local throw true try typedef typeid typename
+	union unsigned using virtual void volatile wchar_t while xor xor_eq`)
+
+// C++ 생성기 - 네임스페이스 옵션을 쓰기 위해 Generator를 직접 구현
+type cppGenerator struct{}
+
+func (cppGenerator) Name() string          { return "cpp" }
+func (cppGenerator) FileExtension() string { return ".hpp" }
+
+func (g cppGenerator) Generate(field models.Field, opts Options) ([]File, error) {
+	code := GenerateCppCode(field, opts.RootName, opts.Namespace, opts.OutputKinds...)
+	return []File{{Path: opts.BaseName + g.FileExtension(), Content: code}}, nil
+}
+
+// C++ 멤버 식별자 (snake_case, 예약어는 _ 접미사)
+func cppIdent(name string) string {
+	id := to_snake_case(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case cppKeywords[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// C++ struct명 (PascalCase)
+func cppTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	}
+	return id
+}
+
+// C++ 타입 변환 (배열은 std::vector, 값이 없을 수 있으면 std::optional)
+// 알 수 없는 값(nlohmann::json)은 null 자체를 담을 수 있으므로 optional로 감싸지 않음
+func cppType(field models.Field) string {
+	t := cppValueType(field)
+	if isNullableField(field) && !cppIsJSONValue(field) {
+		t = fmt.Sprintf("std::optional<%s>", t)
+	}
+	return t
+}
+
+// std::optional을 뺀 값 타입
+func cppValueType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := cppPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("std::vector<%s>", t)
+	}
+	return t
+}
+
+// 기본 타입 → C++ 타입 (알 수 없는 값은 nlohmann::json)
+func cppPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "std::string"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt:
+		return "std::int32_t"
+	case models.TypeLong:
+		return "std::int64_t"
+	case models.TypeFloat:
+		return "double"
+	case models.TypeObject:
+		return "nlohmann::json"
+	}
+	return cppTypeName(t)
+}
+
+func cppIsJSONValue(field models.Field) bool {
+	depth, base := fieldArrayType(field)
+	return depth == 0 && base == models.TypeObject
+}
+
+// C++ 코드 생성기 - header-only struct + nlohmann::json to_json/from_json
+func GenerateCppCode(field models.Field, rootName, namespace string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+	hasJSON := HasKind(outputKinds, OutputJSON)
+
+	sb.WriteString("#pragma once\n\n")
+	sb.WriteString("#include <cstdint>\n")
+	if hasJSON {
+		sb.WriteString("#include <fstream>\n")
+	}
+	sb.WriteString("#include <optional>\n")
+	if hasJSON {
+		sb.WriteString("#include <stdexcept>\n")
+	}
+	sb.WriteString("#include <string>\n")
+	sb.WriteString("#include <vector>\n\n")
+	sb.WriteString("#include <nlohmann/json.hpp>\n\n")
+
+	if namespace != "" {
+		sb.WriteString(fmt.Sprintf("namespace %s {\n\n", namespace))
+	}
+
+	// struct + 변환 함수 (하위 타입 먼저 정의해야 상위 변환 함수에서 사용 가능)
+	for _, t := range outputTypes(field) {
+		writeCppStruct(t, &sb)
+	}
+
+	// JSON 파일 입출력 (XML은 nlohmann에 없어 생략)
+	if hasJSON {
+		for _, r := range outputRoots(field, rootName) {
+			root := cppTypeName(r.Name)
+			fn := to_snake_case(r.Name)
+			sb.WriteString("// 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("inline %s load_%s_from_json_file(const std::string& path) {\n", root, fn))
+			sb.WriteString("    std::ifstream in(path);\n")
+			sb.WriteString("    if (!in) throw std::runtime_error(\"cannot open \" + path);\n")
+			sb.WriteString(fmt.Sprintf("    return nlohmann::json::parse(in).get<%s>();\n}\n\n", root))
+			sb.WriteString("// JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("inline void save_%s_to_json_file(const std::string& path, const %s& value) {\n", fn, root))
+			sb.WriteString("    std::ofstream out(path);\n")
+			sb.WriteString("    if (!out) throw std::runtime_error(\"cannot open \" + path);\n")
+			sb.WriteString("    out << nlohmann::json(value).dump(2);\n}\n\n")
+		}
+	}
+
+	if namespace != "" {
+		sb.WriteString(fmt.Sprintf("} // namespace %s\n", namespace))
+	}
+
+	return sb.String()
+}
+
+func writeCppStruct(field models.Field, sb *strings.Builder) {
+	name := cppTypeName(typeName(field))
+	idents := memberIdents(field.Children, cppIdent)
+
+	sb.WriteString(fmt.Sprintf("struct %s {\n", name))
+	for i, c := range field.Children {
+		sb.WriteString(fmt.Sprintf("    %s %s;\n", cppType(c), idents[i]))
+	}
+	sb.WriteString("};\n\n")
+
+	// to_json (생략 가능한 키는 값이 없으면 넣지 않고, null 가능한 키는 null로 기록)
+	sb.WriteString(fmt.Sprintf("inline void to_json(nlohmann::json& j, const %s& v) {\n", name))
+	sb.WriteString("    j = nlohmann::json::object();\n")
+	for i, c := range field.Children {
+		key := escapeString(wireName(c))
+		member := "v." + idents[i]
+		switch {
+		case cppIsJSONValue(c) && c.Optional:
+			sb.WriteString(fmt.Sprintf("    if (!%s.is_null()) j[\"%s\"] = %s;\n", member, key, member))
+		case cppIsJSONValue(c) || !isNullableField(c):
+			sb.WriteString(fmt.Sprintf("    j[\"%s\"] = %s;\n", key, member))
+		case c.Optional:
+			sb.WriteString(fmt.Sprintf("    if (%s) j[\"%s\"] = *%s;\n", member, key, member))
+		default:
+			sb.WriteString(fmt.Sprintf("    j[\"%s\"] = %s ? nlohmann::json(*%s) : nlohmann::json(nullptr);\n", key, member, member))
+		}
+	}
+	sb.WriteString("}\n\n")
+
+	// from_json (값이 없을 수 있는 키는 없거나 null이면 비워 둠)
+	sb.WriteString(fmt.Sprintf("inline void from_json(const nlohmann::json& j, %s& v) {\n", name))
+	if len(field.Children) == 0 {
+		sb.WriteString("    (void)j;\n    (void)v;\n")
+	}
+	for i, c := range field.Children {
+		key := escapeString(wireName(c))
+		member := "v." + idents[i]
+		switch {
+		case cppIsJSONValue(c):
+			sb.WriteString(fmt.Sprintf("    %s = j.value(\"%s\", nlohmann::json());\n", member, key))
+		case !isNullableField(c):
+			sb.WriteString(fmt.Sprintf("    j.at(\"%s\").get_to(%s);\n", key, member))
+		default:
+			sb.WriteString(fmt.Sprintf("    if (auto it = j.find(\"%s\"); it != j.end() && !it->is_null()) {\n", key))
+			sb.WriteString(fmt.Sprintf("        %s = it->get<%s>();\n", member, cppValueType(c)))
+			sb.WriteString("    } else {\n")
+			sb.WriteString(fmt.Sprintf("        %s.reset();\n    }\n", member))
+		}
+	}
+	sb.WriteString("}\n\n")
+}
@@ -7,12 +7,37 @@ import (
 	"github.com/nosuk/CodeGenerator/models"
 )
 
+func init() {
+	Register(NewSingleFileGenerator("csharp", ".cs", GenerateCSharpCode))
+}
+
 // C# 타입 변환 (배열은 List<>)
 func csharpType(field models.Field) string {
-	if field.IsArray {
-		return fmt.Sprintf("List<%s>", field.Type)
+	depth, base := fieldArrayType(field)
+	t := csharpPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List<%s>", t)
+	}
+	return t
+}
+
+// 기본 타입 → C# 타입 (클래스명은 식별자 규칙 적용)
+func csharpPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "string"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt:
+		return "int"
+	case models.TypeLong:
+		return "long"
+	case models.TypeFloat:
+		return "double"
+	case models.TypeObject:
+		return "object"
 	}
-	return field.Type
+	return csharpTypeName(t)
 }
 
 // 배열 타입에서 아이템명 추출 (ex: List<Role> → Role)
@@ -24,41 +49,42 @@ func GenerateCSharpCode(field models.Field, rootClassName string, outputKinds ..
 	sb.WriteString("using System;\nusing System.IO;\nusing System.Collections.Generic;\nusing Newtonsoft.Json;\nusing System.Xml.Serialization;\n\n")
 
 	// 하위 클래스(루트 제외) 정의
-	writeCSharpClassTree(field, rootClassName, &sb)
-
-	// 루트 모델 클래스
-	sb.WriteString(fmt.Sprintf("[XmlRoot(ElementName=\"%s\")]\n", field.Name))
-	sb.WriteString(fmt.Sprintf("public class %s\n{\n", field.Name))
-	for _, child := range field.Children {
-		if child.IsArray {
-			// 배열/리스트: [JsonProperty], [XmlArray], [XmlArrayItem]
-			sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\")]\n", toCamelCase(child.Name)))
-			sb.WriteString(fmt.Sprintf("    [XmlArray(\"%s\")]\n", child.Name))
-			sb.WriteString(fmt.Sprintf("    [XmlArrayItem(\"%s\")]\n", arrayItemType(child)))
-			sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }\n", csharpType(child), child.Name))
-		} else {
-			// 단일값: [JsonProperty], [XmlElement]
-			sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\")]\n", toCamelCase(child.Name)))
-			sb.WriteString(fmt.Sprintf("    [XmlElement(\"%s\")]\n", child.Name))
-			sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }\n", csharpType(child), child.Name))
+	writeCSharpClassTree(field, &sb)
+
+	// 루트 모델 클래스 (루트 묶음이면 생략)
+	if !field.Bundle {
+		sb.WriteString(fmt.Sprintf("[XmlRoot(ElementName=\"%s\")]\n", escapeString(wireName(field))))
+		className := csharpTypeName(typeName(field))
+		sb.WriteString(fmt.Sprintf("public class %s\n{\n", className))
+		idents := memberIdents(field.Children, csharpIdent, className)
+		for i, child := range field.Children {
+			writeCSharpProperty(child, idents[i], &sb)
 		}
+		sb.WriteString("}\n\n")
 	}
-	sb.WriteString("}\n\n")
 
-	// IO static class (.NET 4.7.2)
-	sb.WriteString(fmt.Sprintf("public static class %sIO\n{\n", rootClassName))
+	for _, root := range outputRoots(field, rootClassName) {
+		writeCSharpIO(csharpTypeName(root.Name), &sb, outputKinds)
+	}
+
+	return sb.String()
+}
+
+// 루트 타입 1개의 IO static class (.NET 4.7.2)
+func writeCSharpIO(className string, sb *strings.Builder, outputKinds []OutputKind) {
+	sb.WriteString(fmt.Sprintf("public static class %sIO\n{\n", className))
 
 	// JSON 함수
 	if HasKind(outputKinds, OutputJSON) {
 		sb.WriteString(fmt.Sprintf("    // JSON 입출력\n"))
-		sb.WriteString(fmt.Sprintf("    public static %s LoadFromJsonFile(string path)\n", rootClassName))
-		sb.WriteString(fmt.Sprintf("    {\n        var json = File.ReadAllText(path);\n        return JsonConvert.DeserializeObject<%s>(json);\n    }\n\n", rootClassName))
-		sb.WriteString(fmt.Sprintf("    public static void SaveToJsonFile(string path, %s data)\n", rootClassName))
+		sb.WriteString(fmt.Sprintf("    public static %s LoadFromJsonFile(string path)\n", className))
+		sb.WriteString(fmt.Sprintf("    {\n        var json = File.ReadAllText(path);\n        return JsonConvert.DeserializeObject<%s>(json);\n    }\n\n", className))
+		sb.WriteString(fmt.Sprintf("    public static void SaveToJsonFile(string path, %s data)\n", className))
 		sb.WriteString(fmt.Sprintf("    {\n        var json = JsonConvert.SerializeObject(data);\n        File.WriteAllText(path, json);\n    }\n\n"))
-		sb.WriteString(fmt.Sprintf("    public static string MarshalJson(%s data)\n", rootClassName))
+		sb.WriteString(fmt.Sprintf("    public static string MarshalJson(%s data)\n", className))
 		sb.WriteString("    {\n        return JsonConvert.SerializeObject(data);\n    }\n\n")
-		sb.WriteString(fmt.Sprintf("    public static %s UnmarshalJson(string json)\n", rootClassName))
-		sb.WriteString(fmt.Sprintf("    {\n        return JsonConvert.DeserializeObject<%s>(json);\n    }\n\n", rootClassName))
+		sb.WriteString(fmt.Sprintf("    public static %s UnmarshalJson(string json)\n", className))
+		sb.WriteString(fmt.Sprintf("    {\n        return JsonConvert.DeserializeObject<%s>(json);\n    }\n\n", className))
 	}
 
 	// XML 함수
@@ -67,54 +93,83 @@ func GenerateCSharpCode(field models.Field, rootClassName string, outputKinds ..
 		sb.WriteString(fmt.Sprintf(
 			"    public static %s LoadFromXmlFile(string path)\n"+
 				"    {\n        using (var stream = File.OpenRead(path))\n        {\n            var serializer = new XmlSerializer(typeof(%s));\n            return (%s)serializer.Deserialize(stream);\n        }\n    }\n\n",
-			rootClassName, rootClassName, rootClassName,
+			className, className, className,
 		))
 		sb.WriteString(fmt.Sprintf(
 			"    public static void SaveToXmlFile(string path, %s data)\n"+
 				"    {\n        using (var stream = File.Create(path))\n        {\n            var serializer = new XmlSerializer(typeof(%s));\n            serializer.Serialize(stream, data);\n        }\n    }\n\n",
-			rootClassName, rootClassName,
+			className, className,
 		))
 		sb.WriteString(fmt.Sprintf(
 			"    public static string MarshalXml(%s data)\n"+
 				"    {\n        using (var ms = new MemoryStream())\n        {\n            var serializer = new XmlSerializer(typeof(%s));\n            serializer.Serialize(ms, data);\n            ms.Position = 0;\n            using (var reader = new StreamReader(ms))\n            {\n                return reader.ReadToEnd();\n            }\n        }\n    }\n\n",
-			rootClassName, rootClassName,
+			className, className,
 		))
 		sb.WriteString(fmt.Sprintf(
 			"    public static %s UnmarshalXml(string xml)\n"+
 				"    {\n        var bytes = System.Text.Encoding.UTF8.GetBytes(xml);\n        using (var ms = new MemoryStream(bytes))\n        {\n            var serializer = new XmlSerializer(typeof(%s));\n            return (%s)serializer.Deserialize(ms);\n        }\n    }\n",
-			rootClassName, rootClassName, rootClassName,
+			className, className, className,
 		))
 	}
 
 	sb.WriteString("}\n\n")
-
-	return sb.String()
 }
 
-// 하위 클래스(루트 제외)도 배열/단일 분기 적용해서 생성
-func writeCSharpClassTree(field models.Field, rootClassName string, sb *strings.Builder) {
-	for _, child := range field.Children {
-		if child.IsComplex {
-			writeCSharpClassTree(child, rootClassName, sb)
-			if child.Name != rootClassName {
-				sb.WriteString(fmt.Sprintf("[XmlType(TypeName=\"%s\")]\n", child.Name))
-				sb.WriteString(fmt.Sprintf("public class %s\n{\n", child.Name))
-				for _, grandChild := range child.Children {
-					if grandChild.IsArray {
-						sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\")]\n", toCamelCase(grandChild.Name)))
-						sb.WriteString(fmt.Sprintf("    [XmlArray(\"%s\")]\n", grandChild.Name))
-						sb.WriteString(fmt.Sprintf("    [XmlArrayItem(\"%s\")]\n", arrayItemType(grandChild)))
-						sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }\n", csharpType(grandChild), grandChild.Name))
-					} else {
-						sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\")]\n", toCamelCase(grandChild.Name)))
-						sb.WriteString(fmt.Sprintf("    [XmlElement(\"%s\")]\n", grandChild.Name))
-						sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }\n", csharpType(grandChild), grandChild.Name))
-					}
-				}
-				sb.WriteString("}\n\n")
-			}
+// 하위 클래스(루트 제외) 생성 - 같은 타입은 한 번만
+func writeCSharpClassTree(field models.Field, sb *strings.Builder) {
+	for _, child := range collectComplexTypes(field) {
+		sb.WriteString(fmt.Sprintf("[XmlType(TypeName=\"%s\")]\n", typeName(child)))
+		className := csharpTypeName(typeName(child))
+		sb.WriteString(fmt.Sprintf("public class %s\n{\n", className))
+		// 멤버명은 클래스명과 같을 수 없음 (CS0542)
+		idents := memberIdents(child.Children, csharpIdent, className)
+		for i, grandChild := range child.Children {
+			writeCSharpProperty(grandChild, idents[i], sb)
 		}
+		sb.WriteString("}\n\n")
+	}
+}
+
+// 프로퍼티 1개 생성 (JSON 속성 + XML 노드 종류별 어트리뷰트)
+func writeCSharpProperty(child models.Field, ident string, sb *strings.Builder) {
+	if child.Optional {
+		sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\", NullValueHandling = NullValueHandling.Ignore)]\n", escapeString(wireName(child))))
+	} else {
+		sb.WriteString(fmt.Sprintf("    [JsonProperty(\"%s\")]\n", escapeString(wireName(child))))
+	}
+	switch {
+	case child.XMLKind == models.XMLAttribute:
+		sb.WriteString(fmt.Sprintf("    [XmlAttribute(\"%s\")]\n", escapeString(wireName(child))))
+	case child.XMLKind == models.XMLCharData || child.XMLKind == models.XMLInnerXML:
+		// XmlSerializer는 innerxml을 지원하지 않으므로 텍스트로 매핑
+		sb.WriteString("    [XmlText]\n")
+	case child.IsArray && isXMLWrappedArray(child):
+		// 배열/리스트: [XmlArray], [XmlArrayItem]
+		sb.WriteString(fmt.Sprintf("    [XmlArray(\"%s\")]\n", escapeString(wireName(child))))
+		sb.WriteString(fmt.Sprintf("    [XmlArrayItem(\"%s\")]\n", xmlItemName(child)))
+	default:
+		// 단일값 또는 래퍼 없는 반복 요소: [XmlElement]
+		sb.WriteString(fmt.Sprintf("    [XmlElement(\"%s\")]\n", escapeString(wireName(child))))
+	}
+	sb.WriteString(fmt.Sprintf("    public %s %s { get; set; }\n", csharpPropertyType(child), ident))
+}
+
+// 값이 없을 수 있는 값 타입은 Nullable<T> (int?)
+// 참조 타입(string, 클래스, List)은 C# 7.3에서 이미 null 허용
+func csharpPropertyType(field models.Field) string {
+	t := csharpType(field)
+	if isNullableField(field) && !field.IsArray && isCSharpValueType(t) {
+		return t + "?"
+	}
+	return t
+}
+
+func isCSharpValueType(t string) bool {
+	switch t {
+	case "bool", "int", "long", "double":
+		return true
 	}
+	return false
 }
 
 // OutputKind, HasKind 등 공통 유틸은 common.go에서 제공
@@ -0,0 +1,221 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("dart", ".dart", GenerateDartCode))
+}
+
+var (
+	dartKeywords = newWordSet(`assert break case catch class const continue default do else enum extends false
+		final finally for if in is new null rethrow return super switch this throw true try var void while with`)
+	// 표준 타입과 겹치는 클래스명
+	dartReservedTypes = newWordSet(`DateTime Duration Function Future Iterable List Map Never Null Object Set
+		Stream String Symbol Type bool double dynamic int num`)
+	// 생성되는 멤버/Object 멤버와 겹치는 프로퍼티명
+	dartMemberNames = []string{"fromJson", "toJson", "hashCode", "runtimeType", "toString", "noSuchMethod"}
+)
+
+// Dart 프로퍼티 식별자 (camelCase, _로 시작하면 private이 되므로 숫자 시작은 field 접두사)
+func dartIdent(name string) string {
+	id := camelCase(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "field" + id
+	case dartKeywords[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Dart 클래스명 (PascalCase, 표준 타입과 겹치면 _ 접미사)
+func dartTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type_"
+	case startsWithDigit(id):
+		return "Type" + id
+	case dartReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Dart 타입 변환 (배열은 List<T>, 값이 없을 수 있으면 ?)
+func dartType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := dartPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List<%s>", t)
+	}
+	if isNullableField(field) && t != "dynamic" {
+		t += "?"
+	}
+	return t
+}
+
+// 기본 타입 → Dart 타입 (알 수 없는 값은 dynamic)
+func dartPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt, models.TypeLong:
+		return "int"
+	case models.TypeFloat:
+		return "double"
+	case models.TypeObject:
+		return "dynamic"
+	}
+	return dartTypeName(t)
+}
+
+// Dart 코드 생성기 - @JsonSerializable 클래스 (fromJson/toJson을 직접 작성해 build_runner 불필요)
+func GenerateDartCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+	hasJSON := HasKind(outputKinds, OutputJSON)
+
+	if hasJSON {
+		sb.WriteString("import 'dart:convert';\n")
+		sb.WriteString("import 'dart:io';\n\n")
+	}
+	sb.WriteString("import 'package:json_annotation/json_annotation.dart';\n\n")
+
+	// 클래스 (하위 클래스 먼저)
+	for _, t := range outputTypes(field) {
+		writeDartClass(t, &sb)
+	}
+
+	// JSON 파일 입출력 (XML은 표준 라이브러리에 없어 생략)
+	if hasJSON {
+		for i, r := range outputRoots(field, rootName) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			root := dartTypeName(r.Name)
+			sb.WriteString("/// 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("%s load%sFromJsonFile(String path) =>\n", root, root))
+			sb.WriteString(fmt.Sprintf("    %s.fromJson(jsonDecode(File(path).readAsStringSync()) as Map<String, dynamic>);\n\n", root))
+			sb.WriteString("/// JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("void save%sToJsonFile(String path, %s value) =>\n", root, root))
+			sb.WriteString("    File(path).writeAsStringSync(const JsonEncoder.withIndent('  ').convert(value.toJson()));\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func writeDartClass(field models.Field, sb *strings.Builder) {
+	name := dartTypeName(typeName(field))
+	idents := memberIdents(field.Children, dartIdent, dartMemberNames...)
+
+	sb.WriteString("@JsonSerializable()\n")
+	sb.WriteString(fmt.Sprintf("class %s {\n", name))
+
+	// 필드
+	for i, c := range field.Children {
+		key := dartString(wireName(c))
+		if c.Optional {
+			sb.WriteString(fmt.Sprintf("  @JsonKey(name: %s, includeIfNull: false)\n", key))
+		} else {
+			sb.WriteString(fmt.Sprintf("  @JsonKey(name: %s)\n", key))
+		}
+		sb.WriteString(fmt.Sprintf("  final %s %s;\n\n", dartType(c), idents[i]))
+	}
+
+	// 생성자 (null이 될 수 없는 필드만 required)
+	if len(field.Children) == 0 {
+		sb.WriteString(fmt.Sprintf("  const %s();\n\n", name))
+	} else {
+		sb.WriteString(fmt.Sprintf("  %s({\n", name))
+		for i, c := range field.Children {
+			if isNullableField(c) || dartType(c) == "dynamic" {
+				sb.WriteString(fmt.Sprintf("    this.%s,\n", idents[i]))
+			} else {
+				sb.WriteString(fmt.Sprintf("    required this.%s,\n", idents[i]))
+			}
+		}
+		sb.WriteString("  });\n\n")
+	}
+
+	// fromJson
+	sb.WriteString(fmt.Sprintf("  factory %s.fromJson(Map<String, dynamic> json) => %s(\n", name, name))
+	for i, c := range field.Children {
+		depth, base := fieldArrayType(c)
+		value := fmt.Sprintf("json[%s]", dartString(wireName(c)))
+		sb.WriteString(fmt.Sprintf("        %s: %s,\n", idents[i], dartFromJsonExpr(value, depth, base, isNullableField(c))))
+	}
+	sb.WriteString("      );\n\n")
+
+	// toJson (생략 가능한 키는 null이면 넣지 않음)
+	sb.WriteString("  Map<String, dynamic> toJson() => <String, dynamic>{\n")
+	for i, c := range field.Children {
+		depth, base := fieldArrayType(c)
+		value := dartToJsonExpr(idents[i], depth, base, isNullableField(c))
+		if c.Optional {
+			sb.WriteString(fmt.Sprintf("        if (%s != null) %s: %s,\n", idents[i], dartString(wireName(c)), value))
+		} else {
+			sb.WriteString(fmt.Sprintf("        %s: %s,\n", dartString(wireName(c)), value))
+		}
+	}
+	sb.WriteString("      };\n}\n\n")
+}
+
+// JSON 값 → Dart 값 변환식 (배열은 원소마다 재귀)
+func dartFromJsonExpr(value string, depth int, base string, nullable bool) string {
+	q := ""
+	if nullable {
+		q = "?"
+	}
+	if depth > 0 {
+		item := dartFromJsonExpr("e", depth-1, base, false)
+		return fmt.Sprintf("(%s as List<dynamic>%s)%s.map((e) => %s).toList()", value, q, q, item)
+	}
+	switch base {
+	case models.TypeObject:
+		return value
+	case models.TypeFloat:
+		return fmt.Sprintf("(%s as num%s)%s.toDouble()", value, q, q)
+	case models.TypeString, models.TypeBool, models.TypeInt, models.TypeLong:
+		return fmt.Sprintf("%s as %s%s", value, dartPrimitive(base), q)
+	}
+	expr := fmt.Sprintf("%s.fromJson(%s as Map<String, dynamic>)", dartTypeName(base), value)
+	if nullable {
+		return fmt.Sprintf("%s == null ? null : %s", value, expr)
+	}
+	return expr
+}
+
+// Dart 값 → JSON 값 변환식 (하위 클래스는 toJson 호출)
+func dartToJsonExpr(value string, depth int, base string, nullable bool) string {
+	q := ""
+	if nullable {
+		q = "?"
+	}
+	switch {
+	case depth > 0:
+		item := dartToJsonExpr("e", depth-1, base, false)
+		if item == "e" {
+			return value
+		}
+		return fmt.Sprintf("%s%s.map((e) => %s).toList()", value, q, item)
+	case isComplexType(base):
+		return fmt.Sprintf("%s%s.toJson()", value, q)
+	}
+	return value
+}
+
+// Dart 작은따옴표 문자열 리터럴 ($는 보간으로 해석되므로 이스케이프)
+func dartString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `'`, `\'`, `$`, `\$`, "\n", `\n`)
+	return "'" + r.Replace(s) + "'"
+}
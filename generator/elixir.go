@@ -0,0 +1,171 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("elixir", ".ex", GenerateElixirCode))
+}
+
+// 구조체 키로 쓰면 의미가 달라지는 atom
+var elixirReservedKeys = newWordSet(`nil true false __struct__`)
+
+// Elixir 구조체 키 (snake_case atom)
+func elixirIdent(name string) string {
+	id := to_snake_case(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case elixirReservedKeys[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Elixir 모듈명 (하위 타입은 루트 모듈 아래: Root.Address, 루트가 없으면(rootName == "") 타입명만)
+func elixirModuleName(rootName, t string) string {
+	if rootName == "" {
+		return rubyClassName(t)
+	}
+	root := rubyClassName(rootName)
+	if t == rootName {
+		return root
+	}
+	return root + "." + rubyClassName(t)
+}
+
+// typespec 변환 (배열은 [T], 값이 없을 수 있으면 | nil)
+func elixirType(field models.Field, rootName string) string {
+	depth, base := fieldArrayType(field)
+	t := elixirPrimitive(base, rootName)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("[%s]", t)
+	}
+	if isNullableField(field) && t != "any()" {
+		t += " | nil"
+	}
+	return t
+}
+
+// 기본 타입 → typespec
+func elixirPrimitive(t, rootName string) string {
+	switch t {
+	case models.TypeString:
+		return "String.t()"
+	case models.TypeBool:
+		return "boolean()"
+	case models.TypeInt, models.TypeLong:
+		return "integer()"
+	case models.TypeFloat:
+		return "float()"
+	case models.TypeObject:
+		return "any()"
+	}
+	return elixirModuleName(rootName, t) + ".t()"
+}
+
+// Elixir 코드 생성기 - defstruct 모듈 + @type t + decode/1 (Jason 등으로 디코딩한 맵 → 구조체)
+func GenerateElixirCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	// 모듈 정의 (하위 모듈부터, Python과 같은 순서)
+	// 파일 입출력 함수는 루트 모듈에 (루트 묶음이면 루트 모듈 없이 스키마 모듈마다, 모듈도 최상위)
+	root := typeName(field)
+	if field.Bundle {
+		root = ""
+	}
+	for _, child := range collectComplexTypes(field) {
+		_, isRoot := bundleRoot(field, child)
+		writeElixirModule(child, root, isRoot && HasKind(outputKinds, OutputJSON), &sb)
+	}
+	if !field.Bundle {
+		writeElixirModule(field, root, HasKind(outputKinds, OutputJSON), &sb)
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+func writeElixirModule(field models.Field, rootName string, withJSONFile bool, sb *strings.Builder) {
+	module := elixirModuleName(rootName, typeName(field))
+	idents := memberIdents(field.Children, elixirIdent)
+
+	sb.WriteString(fmt.Sprintf("defmodule %s do\n", module))
+	sb.WriteString("  @moduledoc false\n\n")
+
+	// 구조체 + 타입
+	keys := make([]string, len(idents))
+	for i, id := range idents {
+		keys[i] = ":" + id
+	}
+	sb.WriteString(fmt.Sprintf("  defstruct [%s]\n\n", strings.Join(keys, ", ")))
+	if len(field.Children) == 0 {
+		sb.WriteString("  @type t :: %__MODULE__{}\n\n")
+	} else {
+		sb.WriteString("  @type t :: %__MODULE__{\n")
+		for i, c := range field.Children {
+			sb.WriteString(fmt.Sprintf("          %s: %s%s\n", idents[i], elixirType(c, rootName), if_comma(i, field.Children)))
+		}
+		sb.WriteString("        }\n\n")
+	}
+
+	// decode (문자열 키 맵 → 구조체)
+	sb.WriteString("  @doc \"JSON 디코딩 결과(문자열 키 맵)를 구조체로 변환\"\n")
+	sb.WriteString("  @spec decode(map() | nil) :: t() | nil\n")
+	sb.WriteString("  def decode(nil), do: nil\n\n")
+	usesList := false
+	if len(field.Children) == 0 {
+		sb.WriteString("  def decode(map) when is_map(map), do: %__MODULE__{}\n")
+	} else {
+		sb.WriteString("  def decode(map) when is_map(map) do\n")
+		sb.WriteString("    %__MODULE__{\n")
+		for i, c := range field.Children {
+			depth, base := fieldArrayType(c)
+			value := fmt.Sprintf("Map.get(map, \"%s\")", escapeElixirString(wireName(c)))
+			if depth > 0 && isComplexType(base) {
+				usesList = true
+			}
+			sb.WriteString(fmt.Sprintf("      %s: %s%s\n", idents[i], elixirDecodeExpr(value, depth, base, rootName), if_comma(i, field.Children)))
+		}
+		sb.WriteString("    }\n  end\n")
+	}
+
+	// JSON 파일 읽기 (루트 모듈만, Jason 사용)
+	if withJSONFile {
+		sb.WriteString("\n  @doc \"파일에서 JSON 읽기\"\n")
+		sb.WriteString("  @spec load_json_file(Path.t()) :: t()\n")
+		sb.WriteString("  def load_json_file(path) do\n")
+		sb.WriteString("    path |> File.read!() |> Jason.decode!() |> decode()\n  end\n")
+	}
+
+	if usesList {
+		sb.WriteString("\n  defp decode_list(nil, _fun), do: nil\n")
+		sb.WriteString("  defp decode_list(list, fun) when is_list(list), do: Enum.map(list, fun)\n")
+	}
+	sb.WriteString("end\n\n")
+}
+
+// 맵 값 → 구조체 값 변환식 (하위 모듈은 decode/1, 배열은 원소마다 재귀)
+func elixirDecodeExpr(value string, depth int, base, rootName string) string {
+	if !isComplexType(base) {
+		return value
+	}
+	fun := fmt.Sprintf("&%s.decode/1", elixirModuleName(rootName, base))
+	for i := 1; i < depth; i++ {
+		fun = fmt.Sprintf("fn list -> decode_list(list, %s) end", fun)
+	}
+	if depth > 0 {
+		return fmt.Sprintf("decode_list(%s, %s)", value, fun)
+	}
+	return fmt.Sprintf("%s.decode(%s)", elixirModuleName(rootName, base), value)
+}
+
+// Elixir 문자열은 #{}를 보간으로 해석하므로 #도 이스케이프
+func escapeElixirString(s string) string {
+	return strings.ReplaceAll(escapeString(s), "#", `\#`)
+}
@@ -7,12 +7,33 @@ import (
 	"github.com/nosuk/CodeGenerator/models"
 )
 
+func init() {
+	Register(NewSingleFileGenerator("go", ".go", GenerateGoCode))
+}
+
 // Go 타입 변환: 배열이면 []타입, 아니면 타입명
 func goType(field models.Field) string {
-	if field.IsArray {
-		return "[]" + field.Type
+	depth, base := fieldArrayType(field)
+	return strings.Repeat("[]", depth) + goPrimitive(base)
+}
+
+// 기본 타입 → Go 타입 (struct명은 식별자 규칙 적용)
+func goPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "string"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt:
+		return "int"
+	case models.TypeLong:
+		return "int64"
+	case models.TypeFloat:
+		return "float64"
+	case models.TypeObject:
+		return "interface{}"
 	}
-	return field.Type
+	return goIdent(t)
 }
 
 // Go 코드 생성기 (JSON/XML 동시 지원)
@@ -22,56 +43,106 @@ func GenerateGoCode(field models.Field, rootName string, outputKinds ...OutputKi
 	sb.WriteString("import (\n\t\"encoding/json\"\n\t\"encoding/xml\"\n\t\"os\"\n\t\"io/ioutil\"\n)\n\n")
 
 	// struct 정의 (하위 struct 먼저)
-	writeGoStructs(field, rootName, &sb)
+	writeGoStructs(field, &sb)
 
+	for _, root := range outputRoots(field, rootName) {
+		writeGoIO(goIdent(root.Name), &sb, outputKinds)
+	}
+	return sb.String()
+}
+
+// 루트 타입 1개의 파일 입출력 함수
+func writeGoIO(name string, sb *strings.Builder, outputKinds []OutputKind) {
 	// JSON 입출력
 	if HasKind(outputKinds, OutputJSON) {
-		sb.WriteString(fmt.Sprintf("// 파일에서 JSON 읽기\nfunc Load%sFromJSONFile(path string) (%s, error) {\n", rootName, rootName))
-		sb.WriteString(fmt.Sprintf("    var v %s\n", rootName))
+		sb.WriteString(fmt.Sprintf("// 파일에서 JSON 읽기\nfunc Load%sFromJSONFile(path string) (%s, error) {\n", name, name))
+		sb.WriteString(fmt.Sprintf("    var v %s\n", name))
 		sb.WriteString("    data, err := ioutil.ReadFile(path)\n    if err != nil { return v, err }\n")
 		sb.WriteString("    err = json.Unmarshal(data, &v)\n    return v, err\n}\n\n")
 
-		sb.WriteString(fmt.Sprintf("// JSON 파일로 저장\nfunc Save%sToJSONFile(path string, v %s) error {\n", rootName, rootName))
+		sb.WriteString(fmt.Sprintf("// JSON 파일로 저장\nfunc Save%sToJSONFile(path string, v %s) error {\n", name, name))
 		sb.WriteString("    data, err := json.MarshalIndent(v, \"\", \"  \")\n    if err != nil { return err }\n")
 		sb.WriteString("    return ioutil.WriteFile(path, data, 0644)\n}\n\n")
 	}
 
 	// XML 입출력
 	if HasKind(outputKinds, OutputXML) {
-		sb.WriteString(fmt.Sprintf("// 파일에서 XML 읽기\nfunc Load%sFromXMLFile(path string) (%s, error) {\n", rootName, rootName))
-		sb.WriteString(fmt.Sprintf("    var v %s\n", rootName))
+		sb.WriteString(fmt.Sprintf("// 파일에서 XML 읽기\nfunc Load%sFromXMLFile(path string) (%s, error) {\n", name, name))
+		sb.WriteString(fmt.Sprintf("    var v %s\n", name))
 		sb.WriteString("    data, err := ioutil.ReadFile(path)\n    if err != nil { return v, err }\n")
 		sb.WriteString("    err = xml.Unmarshal(data, &v)\n    return v, err\n}\n\n")
 
-		sb.WriteString(fmt.Sprintf("// XML 파일로 저장\nfunc Save%sToXMLFile(path string, v %s) error {\n", rootName, rootName))
+		sb.WriteString(fmt.Sprintf("// XML 파일로 저장\nfunc Save%sToXMLFile(path string, v %s) error {\n", name, name))
 		sb.WriteString("    data, err := xml.MarshalIndent(v, \"\", \"  \")\n    if err != nil { return err }\n")
 		sb.WriteString("    return ioutil.WriteFile(path, data, 0644)\n}\n\n")
 	}
-	return sb.String()
 }
 
-// 하위 struct(루트 제외) 재귀 생성
-func writeGoStructs(field models.Field, rootName string, sb *strings.Builder) {
-	for _, child := range field.Children {
-		if child.IsComplex {
-			writeGoStructs(child, rootName, sb)
-			if child.Name != rootName {
-				sb.WriteString(fmt.Sprintf("type %s struct {\n", child.Name))
-				for _, gc := range child.Children {
-					sb.WriteString(fmt.Sprintf("    %s %s `json:\"%s\" xml:\"%s\"`\n", gc.Name, goType(gc), gc.Name, gc.Name))
-				}
-				sb.WriteString("}\n\n")
-			}
+// 하위 struct(루트 제외, 같은 타입은 한 번만) 생성 후 루트 struct 생성 (루트 묶음이면 생략)
+func writeGoStructs(field models.Field, sb *strings.Builder) {
+	for _, child := range collectComplexTypes(field) {
+		sb.WriteString(fmt.Sprintf("type %s struct {\n", goIdent(typeName(child))))
+		idents := memberIdents(child.Children, goIdent)
+		for i, gc := range child.Children {
+			writeGoStructField(gc, idents[i], sb)
 		}
+		sb.WriteString("}\n\n")
+	}
+	if field.Bundle {
+		return
 	}
 	// 마지막에 루트 struct 생성
-	if field.Name == rootName {
-		sb.WriteString(fmt.Sprintf("type %s struct {\n", field.Name))
-		for _, child := range field.Children {
-			sb.WriteString(fmt.Sprintf("    %s %s `json:\"%s\" xml:\"%s\"`\n", child.Name, goType(child), child.Name, child.Name))
-		}
-		sb.WriteString("}\n\n")
+	sb.WriteString(fmt.Sprintf("type %s struct {\n", goIdent(typeName(field))))
+	var taken []string
+	if field.WireName != "" {
+		// 루트 요소 이름 유지
+		sb.WriteString(fmt.Sprintf("    XMLName xml.Name `json:\"-\" xml:\"%s\"`\n", escapeString(field.WireName)))
+		taken = append(taken, "XMLName")
+	}
+	idents := memberIdents(field.Children, goIdent, taken...)
+	for i, child := range field.Children {
+		writeGoStructField(child, idents[i], sb)
+	}
+	sb.WriteString("}\n\n")
+}
+
+// struct 필드 1개 생성 (XML 노드 종류별 태그)
+func writeGoStructField(child models.Field, ident string, sb *strings.Builder) {
+	jsonTag := escapeString(wireName(child))
+	if isNullableField(child) {
+		jsonTag += ",omitempty"
+	}
+	sb.WriteString(fmt.Sprintf("    %s %s `json:\"%s\" xml:\"%s\"`\n", ident, goFieldType(child), jsonTag, goXMLTag(child)))
+}
+
+// 값이 없을 수 있는 단일 값은 포인터 (배열은 nil 슬라이스로 표현)
+// omitempty만 쓰면 false/0 같은 값이 누락되므로 포인터로 구분
+func goFieldType(field models.Field) string {
+	t := goType(field)
+	if isNullableField(field) && !field.IsArray && t != "interface{}" {
+		return "*" + t
+	}
+	return t
+}
+
+// encoding/xml 태그 값
+func goXMLTag(field models.Field) string {
+	name := escapeString(wireName(field))
+	omit := ""
+	if isNullableField(field) {
+		omit = ",omitempty"
+	}
+	switch field.XMLKind {
+	case models.XMLAttribute:
+		return name + ",attr" + omit
+	case models.XMLCharData, models.XMLInnerXML:
+		// 자식 요소가 섞인 텍스트도 ,chardata (,innerxml은 Marshal 시 자식 요소 필드와 중복 출력됨)
+		return ",chardata"
+	}
+	if field.IsArray && field.XMLItemName != "" {
+		return name + ">" + escapeString(field.XMLItemName) + omit
 	}
+	return name + omit
 }
 
 // OutputKind 체크는 generator/common.go에서 제공 (import해서 사용)
@@ -0,0 +1,169 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+// 언어별 예약어
+var (
+	// 생성 코드가 참조하는 표준/라이브러리 타입과 겹치는 클래스명
+	csharpReservedTypes = newWordSet(`Encoding File JsonConvert JsonProperty List MemoryStream Newtonsoft
+		NullValueHandling Object StreamReader String System XmlArray XmlArrayItem XmlAttribute XmlElement XmlRoot
+		XmlSerializer XmlText XmlType`)
+	javaReservedTypes = newWordSet(`Boolean Double Exception File Files IOException Integer JAXBContext
+		JsonIgnoreProperties JsonInclude JsonProperty List Long Object ObjectMapper Paths String XmlAccessType
+		XmlAccessorType XmlAnyElement XmlAttribute XmlElement XmlElementWrapper XmlMixed XmlRootElement XmlType
+		XmlValue`)
+	// self는 생성되는 __init__의 첫 인자와 겹치므로 함께 피함
+	pythonKeywords = newWordSet(`False None True and as assert async await break class continue def del elif else
+		except finally for from global if import in is lambda nonlocal not or pass raise return try while with
+		yield self`)
+	// import한 typing 이름과 모듈 별칭
+	pythonReservedTypes = newWordSet(`Any ET List Optional`)
+)
+
+func newWordSet(words string) map[string]bool {
+	set := map[string]bool{}
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// 단어 단위 PascalCase ("postal-code" → "PostalCode", "user_id" → "UserId")
+func pascalCase(name string) string {
+	var sb strings.Builder
+	for _, w := range models.SplitWords(name) {
+		sb.WriteString(models.ToExported(w))
+	}
+	return sb.String()
+}
+
+// 단어 단위 camelCase ("postal-code" → "postalCode", "URL" → "url", "IPAddress" → "ipAddress")
+func camelCase(name string) string {
+	runes := []rune(pascalCase(name))
+	upper := 0
+	for upper < len(runes) && unicode.IsUpper(runes[upper]) {
+		upper++
+	}
+	if upper > 1 && upper < len(runes) {
+		// 약어 + 단어 (IPAddress → ipAddress), 전부 대문자면 전부 소문자
+		upper--
+	}
+	for i := 0; i < upper; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
+
+func startsWithDigit(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsDigit(r)
+}
+
+// C# 식별자: PascalCase, 숫자로 시작하면 _ 접두사 (PascalCase라 소문자 예약어와 겹치지 않음)
+func csharpIdent(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Field"
+	case startsWithDigit(id):
+		return "_" + id
+	}
+	return id
+}
+
+// C# 클래스명: 식별자 규칙 + 참조하는 표준 타입과 겹치면 _ 접미사
+func csharpTypeName(name string) string {
+	id := csharpIdent(name)
+	if csharpReservedTypes[id] {
+		return id + "_"
+	}
+	return id
+}
+
+// Java 식별자: PascalCase, 숫자로 시작하면 _ 접두사 (PascalCase라 소문자 예약어와 겹치지 않음)
+func javaIdent(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Field"
+	case startsWithDigit(id):
+		return "_" + id
+	}
+	return id
+}
+
+// Java 클래스명: 식별자 규칙 + java.lang 등 참조하는 타입과 겹치면 _ 접미사
+func javaTypeName(name string) string {
+	id := javaIdent(name)
+	if javaReservedTypes[id] {
+		return id + "_"
+	}
+	return id
+}
+
+// Go 식별자: 필드가 export 되도록 대문자로 시작 (숫자/한글 등은 X 접두사, 소문자 예약어와는 겹치지 않음)
+func goIdent(name string) string {
+	id := pascalCase(name)
+	if id == "" {
+		return "Field"
+	}
+	if r, _ := utf8.DecodeRuneInString(id); !unicode.IsUpper(r) {
+		id = "X" + id
+	}
+	return id
+}
+
+// Python 속성/인자 식별자: snake_case, 숫자로 시작하면 _ 접두사, 예약어는 _ 접미사
+func pythonIdent(name string) string {
+	id := to_snake_case(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case pythonKeywords[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Python 클래스명: PascalCase 유지, 예약어(None/True/False)만 피함
+func pythonClassName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case pythonKeywords[id], pythonReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// 클래스 멤버 식별자 목록 (원본 이름에 언어별 규칙 적용, 서로 겹치거나 taken과 겹치면 숫자 접미사)
+// C#은 멤버명이 클래스명과 같을 수 없으므로 taken에 클래스명 전달
+func memberIdents(children []models.Field, ident func(string) string, taken ...string) []string {
+	used := map[string]bool{}
+	for _, t := range taken {
+		used[t] = true
+	}
+	result := make([]string, len(children))
+	for i, c := range children {
+		id := ident(wireName(c))
+		candidate := id
+		for n := 2; used[candidate]; n++ {
+			candidate = fmt.Sprintf("%s%d", id, n)
+		}
+		used[candidate] = true
+		result[i] = candidate
+	}
+	return result
+}
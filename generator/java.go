@@ -7,12 +7,55 @@ import (
 	"github.com/nosuk/CodeGenerator/models"
 )
 
-// Java 타입 변환 (배열이면 List<타입>)
+func init() {
+	Register(NewSingleFileGenerator("java", ".java", GenerateJavaCode))
+}
+
+// Java 타입 변환 (배열이면 List<타입>, 원소는 박싱 타입)
 func javaType(field models.Field) string {
-	if field.IsArray {
-		return fmt.Sprintf("List<%s>", field.Type)
+	depth, base := fieldArrayType(field)
+	if depth == 0 {
+		return javaPrimitive(base)
+	}
+	t := javaBoxed(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List<%s>", t)
+	}
+	return t
+}
+
+// 기본 타입 → Java 타입 (클래스명은 식별자 규칙 적용)
+func javaPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "boolean"
+	case models.TypeInt:
+		return "int"
+	case models.TypeLong:
+		return "long"
+	case models.TypeFloat:
+		return "double"
+	case models.TypeObject:
+		return "Object"
 	}
-	return field.Type
+	return javaTypeName(t)
+}
+
+// 제네릭 인자로 쓸 박싱 타입
+func javaBoxed(t string) string {
+	switch t {
+	case models.TypeBool:
+		return "Boolean"
+	case models.TypeInt:
+		return "Integer"
+	case models.TypeLong:
+		return "Long"
+	case models.TypeFloat:
+		return "Double"
+	}
+	return javaPrimitive(t)
 }
 
 func GenerateJavaCode(field models.Field, rootClassName string, outputKinds ...OutputKind) string {
@@ -28,80 +71,104 @@ func GenerateJavaCode(field models.Field, rootClassName string, outputKinds ...O
 	sb.WriteString("import java.util.*;\n\n")
 
 	// 하위 클래스 (루트 제외)
-	writeJavaClassTree(field, rootClassName, &sb)
-
-	// 루트 클래스 정의
-	sb.WriteString(fmt.Sprintf("@XmlRootElement(name=\"%s\")\n", field.Name))
-	sb.WriteString("@XmlAccessorType(XmlAccessType.FIELD)\n")
-	sb.WriteString("@JsonIgnoreProperties(ignoreUnknown=true)\n")
-	sb.WriteString(fmt.Sprintf("public class %s {\n", field.Name))
-	for _, child := range field.Children {
-		if child.IsArray {
-			sb.WriteString(fmt.Sprintf("    @XmlElementWrapper(name=\"%s\")\n", child.Name))
-			sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", arrayItemType(child)))
-			sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", toCamelCase(child.Name)))
-			sb.WriteString(fmt.Sprintf("    public %s %s;\n", javaType(child), child.Name))
-		} else {
-			sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", child.Name))
-			sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", toCamelCase(child.Name)))
-			sb.WriteString(fmt.Sprintf("    public %s %s;\n", javaType(child), child.Name))
+	writeJavaClassTree(field, &sb)
+
+	// 루트 클래스 정의 (루트 묶음이면 생략)
+	if !field.Bundle {
+		sb.WriteString(fmt.Sprintf("@XmlRootElement(name=\"%s\")\n", escapeString(wireName(field))))
+		sb.WriteString("@XmlAccessorType(XmlAccessType.FIELD)\n")
+		sb.WriteString("@JsonIgnoreProperties(ignoreUnknown=true)\n")
+		className := javaTypeName(typeName(field))
+		sb.WriteString(fmt.Sprintf("public class %s {\n", className))
+		idents := memberIdents(field.Children, javaIdent)
+		for i, child := range field.Children {
+			writeJavaField(child, idents[i], &sb)
 		}
+		sb.WriteString(fmt.Sprintf("\n    public %s() {}\n", className))
+		sb.WriteString("}\n\n")
 	}
-	sb.WriteString(fmt.Sprintf("\n    public %s() {}\n", field.Name))
-	sb.WriteString("}\n\n")
 
-	// IO 유틸 클래스 (Jackson + JAXB)
-	sb.WriteString(fmt.Sprintf("class %sIO {\n", rootClassName))
+	for _, root := range outputRoots(field, rootClassName) {
+		writeJavaIO(javaTypeName(root.Name), &sb)
+	}
+
+	return sb.String()
+}
+
+// 루트 타입 1개의 IO 유틸 클래스 (Jackson + JAXB)
+func writeJavaIO(className string, sb *strings.Builder) {
+	sb.WriteString(fmt.Sprintf("class %sIO {\n", className))
 
 	// JSON
-	sb.WriteString(fmt.Sprintf("    public static %s loadFromJsonFile(String path) throws IOException {\n", rootClassName))
+	sb.WriteString(fmt.Sprintf("    public static %s loadFromJsonFile(String path) throws IOException {\n", className))
 	sb.WriteString("        ObjectMapper om = new ObjectMapper();\n")
-	sb.WriteString(fmt.Sprintf("        return om.readValue(Files.readAllBytes(Paths.get(path)), %s.class);\n", rootClassName))
+	sb.WriteString(fmt.Sprintf("        return om.readValue(Files.readAllBytes(Paths.get(path)), %s.class);\n", className))
 	sb.WriteString("    }\n\n")
-	sb.WriteString(fmt.Sprintf("    public static void saveToJsonFile(String path, %s data) throws IOException {\n", rootClassName))
+	sb.WriteString(fmt.Sprintf("    public static void saveToJsonFile(String path, %s data) throws IOException {\n", className))
 	sb.WriteString("        ObjectMapper om = new ObjectMapper();\n")
 	sb.WriteString("        om.writerWithDefaultPrettyPrinter().writeValue(new File(path), data);\n")
 	sb.WriteString("    }\n\n")
 
 	// XML
-	sb.WriteString(fmt.Sprintf("    public static %s loadFromXmlFile(String path) throws Exception {\n", rootClassName))
-	sb.WriteString(fmt.Sprintf("        JAXBContext ctx = JAXBContext.newInstance(%s.class);\n", rootClassName))
-	sb.WriteString(fmt.Sprintf("        return (%s) ctx.createUnmarshaller().unmarshal(new File(path));\n", rootClassName))
+	sb.WriteString(fmt.Sprintf("    public static %s loadFromXmlFile(String path) throws Exception {\n", className))
+	sb.WriteString(fmt.Sprintf("        JAXBContext ctx = JAXBContext.newInstance(%s.class);\n", className))
+	sb.WriteString(fmt.Sprintf("        return (%s) ctx.createUnmarshaller().unmarshal(new File(path));\n", className))
 	sb.WriteString("    }\n\n")
-	sb.WriteString(fmt.Sprintf("    public static void saveToXmlFile(String path, %s data) throws Exception {\n", rootClassName))
-	sb.WriteString(fmt.Sprintf("        JAXBContext ctx = JAXBContext.newInstance(%s.class);\n", rootClassName))
+	sb.WriteString(fmt.Sprintf("    public static void saveToXmlFile(String path, %s data) throws Exception {\n", className))
+	sb.WriteString(fmt.Sprintf("        JAXBContext ctx = JAXBContext.newInstance(%s.class);\n", className))
 	sb.WriteString("        ctx.createMarshaller().marshal(data, new File(path));\n")
 	sb.WriteString("    }\n")
 	sb.WriteString("}\n\n")
 
-	return sb.String()
 }
 
-// 하위 클래스도 JSON+XML 어노테이션 포함
-func writeJavaClassTree(field models.Field, rootClassName string, sb *strings.Builder) {
-	for _, child := range field.Children {
-		if child.IsComplex {
-			writeJavaClassTree(child, rootClassName, sb)
-			if child.Name != rootClassName {
-				sb.WriteString(fmt.Sprintf("@XmlType(name=\"%s\")\n", child.Name))
-				sb.WriteString("@XmlAccessorType(XmlAccessType.FIELD)\n")
-				sb.WriteString("@JsonIgnoreProperties(ignoreUnknown=true)\n")
-				sb.WriteString(fmt.Sprintf("public class %s {\n", child.Name))
-				for _, grandChild := range child.Children {
-					if grandChild.IsArray {
-						sb.WriteString(fmt.Sprintf("    @XmlElementWrapper(name=\"%s\")\n", grandChild.Name))
-						sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", arrayItemType(grandChild)))
-						sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", toCamelCase(grandChild.Name)))
-						sb.WriteString(fmt.Sprintf("    public %s %s;\n", javaType(grandChild), grandChild.Name))
-					} else {
-						sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", grandChild.Name))
-						sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", toCamelCase(grandChild.Name)))
-						sb.WriteString(fmt.Sprintf("    public %s %s;\n", javaType(grandChild), grandChild.Name))
-					}
-				}
-				sb.WriteString(fmt.Sprintf("\n    public %s() {}\n", child.Name))
-				sb.WriteString("}\n\n")
-			}
+// 하위 클래스도 JSON+XML 어노테이션 포함 (같은 타입은 한 번만)
+// 루트 묶음의 스키마 타입은 XML 루트 요소로도 읽고 쓰므로 @XmlRootElement 추가
+func writeJavaClassTree(field models.Field, sb *strings.Builder) {
+	for _, child := range collectComplexTypes(field) {
+		if root, ok := bundleRoot(field, child); ok {
+			sb.WriteString(fmt.Sprintf("@XmlRootElement(name=\"%s\")\n", escapeString(wireName(root))))
+		}
+		sb.WriteString(fmt.Sprintf("@XmlType(name=\"%s\")\n", typeName(child)))
+		sb.WriteString("@XmlAccessorType(XmlAccessType.FIELD)\n")
+		sb.WriteString("@JsonIgnoreProperties(ignoreUnknown=true)\n")
+		className := javaTypeName(typeName(child))
+		sb.WriteString(fmt.Sprintf("public class %s {\n", className))
+		idents := memberIdents(child.Children, javaIdent)
+		for i, grandChild := range child.Children {
+			writeJavaField(grandChild, idents[i], sb)
 		}
+		sb.WriteString(fmt.Sprintf("\n    public %s() {}\n", className))
+		sb.WriteString("}\n\n")
+	}
+}
+
+// 필드 1개 생성 (JAXB 노드 종류별 어노테이션 + Jackson 속성)
+func writeJavaField(child models.Field, ident string, sb *strings.Builder) {
+	fieldType := javaType(child)
+	if isNullableField(child) && !child.IsArray {
+		// 값이 없을 수 있으면 박싱 타입으로 null 표현
+		fieldType = javaBoxed(child.Type)
+	}
+	switch {
+	case child.XMLKind == models.XMLAttribute:
+		sb.WriteString(fmt.Sprintf("    @XmlAttribute(name=\"%s\")\n", escapeString(wireName(child))))
+	case child.XMLKind == models.XMLCharData:
+		sb.WriteString("    @XmlValue\n")
+	case child.XMLKind == models.XMLInnerXML:
+		// 자식 요소가 섞인 텍스트는 문자열/DOM 노드 목록으로 보존
+		sb.WriteString("    @XmlMixed\n")
+		sb.WriteString("    @XmlAnyElement\n")
+		fieldType = "List<Object>"
+	case child.IsArray && isXMLWrappedArray(child):
+		sb.WriteString(fmt.Sprintf("    @XmlElementWrapper(name=\"%s\")\n", escapeString(wireName(child))))
+		sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", xmlItemName(child)))
+	default:
+		sb.WriteString(fmt.Sprintf("    @XmlElement(name=\"%s\")\n", escapeString(wireName(child))))
+	}
+	sb.WriteString(fmt.Sprintf("    @JsonProperty(\"%s\")\n", escapeString(wireName(child))))
+	if isNullableField(child) {
+		sb.WriteString("    @JsonInclude(JsonInclude.Include.NON_NULL)\n")
 	}
+	sb.WriteString(fmt.Sprintf("    public %s %s;\n", fieldType, ident))
 }
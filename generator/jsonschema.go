@@ -0,0 +1,159 @@
+package generator
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(jsonSchemaGenerator{})
+}
+
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// JSON Schema 생성기 - 직렬화 오류를 돌려주기 위해 Generator를 직접 구현
+type jsonSchemaGenerator struct{}
+
+func (jsonSchemaGenerator) Name() string          { return "jsonschema" }
+func (jsonSchemaGenerator) FileExtension() string { return ".schema.json" }
+
+func (g jsonSchemaGenerator) Generate(field models.Field, opts Options) ([]File, error) {
+	code, err := GenerateJSONSchema(field, opts.RootName)
+	if err != nil {
+		return nil, err
+	}
+	return []File{{Path: opts.BaseName + g.FileExtension(), Content: code}}, nil
+}
+
+// JSON Schema 생성 - Draft 2020-12 문서 (중첩 타입은 $defs, 누락 가능 키는 required에서 제외)
+// 코드가 아닌 스키마이므로 입출력 함수 종류(OutputKinds)는 사용하지 않음
+func GenerateJSONSchema(field models.Field, rootName string) (string, error) {
+	if field.Bundle {
+		// 루트 묶음은 타입이 아니므로 문서 이름을 제목으로
+		rootName = wireName(field)
+	}
+	doc := models.OrderedObject{
+		{Key: "$schema", Value: jsonSchemaDialect},
+		{Key: "title", Value: rootName},
+	}
+
+	defs := models.OrderedObject{}
+	for _, t := range collectComplexTypes(field) {
+		defs = append(defs, models.OrderedEntry{Key: typeName(t), Value: jsonSchemaObject(t)})
+	}
+
+	if field.IsArray {
+		// 최상위가 배열이면 원소 타입도 $defs에 두고 참조
+		if field.IsComplex {
+			defs = append(defs, models.OrderedEntry{Key: typeName(field), Value: jsonSchemaObject(field)})
+		}
+		doc = append(doc, jsonSchemaProperty(field)...)
+	} else if !field.Bundle { // 루트 묶음이면 스키마는 $defs에만
+		doc = append(doc, jsonSchemaObject(field)...)
+	}
+	if len(defs) > 0 {
+		doc = append(doc, models.OrderedEntry{Key: "$defs", Value: defs})
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		// enum 값이 올바른 JSON이 아닌 경우 등
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// 복합 타입 → object 스키마
+func jsonSchemaObject(field models.Field) models.OrderedObject {
+	properties := models.OrderedObject{}
+	required := []string{}
+	for _, c := range field.Children {
+		properties = append(properties, models.OrderedEntry{Key: wireName(c), Value: jsonSchemaProperty(c)})
+		if !c.Optional {
+			required = append(required, wireName(c))
+		}
+	}
+
+	schema := models.OrderedObject{
+		{Key: "type", Value: "object"},
+		{Key: "properties", Value: properties},
+	}
+	if len(required) > 0 {
+		schema = append(schema, models.OrderedEntry{Key: "required", Value: required})
+	}
+	return schema
+}
+
+// 필드 1개의 스키마 (배열은 items로 감싸고, null이 관찰되면 null 허용)
+func jsonSchemaProperty(field models.Field) models.OrderedObject {
+	depth, base := fieldArrayType(field)
+	schema := jsonSchemaBase(base, field.Format)
+	if len(field.Enum) > 0 {
+		schema = append(schema, models.OrderedEntry{Key: "enum", Value: jsonSchemaEnum(field.Enum, field.Nullable && depth == 0)})
+	}
+	for i := 0; i < depth; i++ {
+		schema = models.OrderedObject{
+			{Key: "type", Value: "array"},
+			{Key: "items", Value: schema},
+		}
+	}
+	if field.Nullable {
+		schema = jsonSchemaNullable(schema)
+	}
+	return schema
+}
+
+// 기본 타입/참조 스키마 (알 수 없는 값은 모든 값을 허용하는 {})
+func jsonSchemaBase(t, format string) models.OrderedObject {
+	switch t {
+	case models.TypeString:
+		schema := models.OrderedObject{{Key: "type", Value: "string"}}
+		if format != "" {
+			schema = append(schema, models.OrderedEntry{Key: "format", Value: format})
+		}
+		return schema
+	case models.TypeBool:
+		return models.OrderedObject{{Key: "type", Value: "boolean"}}
+	case models.TypeInt, models.TypeLong:
+		return models.OrderedObject{{Key: "type", Value: "integer"}}
+	case models.TypeFloat:
+		return models.OrderedObject{{Key: "type", Value: "number"}}
+	case models.TypeObject:
+		return models.OrderedObject{}
+	}
+	return models.OrderedObject{{Key: "$ref", Value: "#/$defs/" + t}}
+}
+
+// null 허용 (type이 있으면 ["x", "null"], 참조면 anyOf)
+func jsonSchemaNullable(schema models.OrderedObject) models.OrderedObject {
+	if len(schema) == 0 {
+		return schema
+	}
+	if schema[0].Key == "type" {
+		result := append(models.OrderedObject{}, schema...)
+		result[0].Value = []string{schema[0].Value.(string), "null"}
+		return result
+	}
+	return models.OrderedObject{
+		{Key: "anyOf", Value: []models.OrderedObject{schema, {{Key: "type", Value: "null"}}}},
+	}
+}
+
+// enum 값 (Field.Enum은 값마다 JSON 표현, null 허용 필드면 null 포함)
+func jsonSchemaEnum(values []string, nullable bool) []json.RawMessage {
+	result := make([]json.RawMessage, 0, len(values)+1)
+	hasNull := false
+	for _, v := range values {
+		result = append(result, json.RawMessage(v))
+		hasNull = hasNull || v == "null"
+	}
+	if nullable && !hasNull {
+		result = append(result, json.RawMessage("null"))
+	}
+	return result
+}
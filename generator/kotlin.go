@@ -0,0 +1,167 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("kotlin", ".kt", GenerateKotlinCode))
+}
+
+var (
+	kotlinKeywords = newWordSet(`as break class continue do else false for fun if in interface is null object
+		package return super this throw true try typealias typeof val var when while`)
+	// 표준 타입과 겹치는 클래스명
+	kotlinReservedTypes = newWordSet(`Any Array Boolean Double Float Int List Long Map Nothing Set String Unit`)
+)
+
+// Kotlin 프로퍼티 식별자 (camelCase, 예약어는 백틱)
+func kotlinIdent(name string) string {
+	id := camelCase(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case kotlinKeywords[id]:
+		return "`" + id + "`"
+	}
+	return id
+}
+
+// Kotlin 클래스명 (PascalCase, 표준 타입과 겹치면 _ 접미사)
+func kotlinTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case kotlinReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Kotlin 타입 변환 (배열은 List<>, 값이 없을 수 있으면 ?)
+func kotlinType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := kotlinPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List<%s>", t)
+	}
+	if isNullableField(field) || base == models.TypeObject && depth == 0 {
+		t += "?"
+	}
+	return t
+}
+
+// 기본 타입 → Kotlin 타입 (알 수 없는 값은 JsonElement)
+func kotlinPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "Boolean"
+	case models.TypeInt:
+		return "Int"
+	case models.TypeLong:
+		return "Long"
+	case models.TypeFloat:
+		return "Double"
+	case models.TypeObject:
+		return "JsonElement"
+	}
+	return kotlinTypeName(t)
+}
+
+// 프로퍼티 기본값 (모든 프로퍼티에 기본값이 있어야 하위 클래스도 인자 없이 생성 가능)
+func kotlinDefault(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	switch {
+	case isNullableField(field) || base == models.TypeObject && depth == 0:
+		return "null"
+	case depth > 0:
+		return "emptyList()"
+	}
+	switch base {
+	case models.TypeString:
+		return "\"\""
+	case models.TypeBool:
+		return "false"
+	case models.TypeInt:
+		return "0"
+	case models.TypeLong:
+		return "0L"
+	case models.TypeFloat:
+		return "0.0"
+	}
+	return kotlinTypeName(base) + "()"
+}
+
+// Kotlin 코드 생성기 - kotlinx.serialization @Serializable data class
+func GenerateKotlinCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	sb.WriteString("import kotlinx.serialization.SerialName\n")
+	sb.WriteString("import kotlinx.serialization.Serializable\n")
+	sb.WriteString("import kotlinx.serialization.json.Json\n")
+	sb.WriteString("import kotlinx.serialization.json.JsonElement\n")
+	sb.WriteString("import java.io.File\n\n")
+
+	// data class (하위 클래스 먼저)
+	for _, t := range outputTypes(field) {
+		writeKotlinClass(t, &sb)
+	}
+
+	for i, r := range outputRoots(field, rootName) {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		root := kotlinTypeName(r.Name)
+		// IO 유틸 object (Java의 XxxIO와 같은 이름/역할)
+		sb.WriteString(fmt.Sprintf("object %sIO {\n", root))
+		sb.WriteString("    private val json = Json {\n")
+		sb.WriteString("        ignoreUnknownKeys = true\n")
+		sb.WriteString("        explicitNulls = false\n")
+		sb.WriteString("        prettyPrint = true\n")
+		sb.WriteString("    }\n")
+		if HasKind(outputKinds, OutputJSON) {
+			sb.WriteString("\n    // JSON 입출력\n")
+			sb.WriteString(fmt.Sprintf("    fun loadFromJsonFile(path: String): %s =\n", root))
+			sb.WriteString(fmt.Sprintf("        json.decodeFromString(%s.serializer(), File(path).readText())\n\n", root))
+			sb.WriteString(fmt.Sprintf("    fun saveToJsonFile(path: String, data: %s) {\n", root))
+			sb.WriteString(fmt.Sprintf("        File(path).writeText(json.encodeToString(%s.serializer(), data))\n    }\n\n", root))
+			sb.WriteString(fmt.Sprintf("    fun marshalJson(data: %s): String = json.encodeToString(%s.serializer(), data)\n\n", root, root))
+			sb.WriteString(fmt.Sprintf("    fun unmarshalJson(text: String): %s = json.decodeFromString(%s.serializer(), text)\n", root, root))
+		}
+		// kotlinx.serialization에는 표준 XML 포맷이 없으므로 XML 입출력은 생성하지 않음
+		sb.WriteString("}\n")
+	}
+
+	return sb.String()
+}
+
+func writeKotlinClass(field models.Field, sb *strings.Builder) {
+	sb.WriteString("@Serializable\n")
+	if len(field.Children) == 0 {
+		// data class는 프로퍼티가 최소 1개 필요
+		sb.WriteString(fmt.Sprintf("class %s\n\n", kotlinTypeName(typeName(field))))
+		return
+	}
+	sb.WriteString(fmt.Sprintf("data class %s(\n", kotlinTypeName(typeName(field))))
+	idents := memberIdents(field.Children, kotlinIdent)
+	for i, c := range field.Children {
+		sb.WriteString(fmt.Sprintf("    @SerialName(\"%s\")\n", escapeKotlinString(wireName(c))))
+		sb.WriteString(fmt.Sprintf("    val %s: %s = %s,\n", idents[i], kotlinType(c), kotlinDefault(c)))
+	}
+	sb.WriteString(")\n\n")
+}
+
+// Kotlin 문자열은 $도 템플릿으로 해석하므로 함께 이스케이프
+func escapeKotlinString(s string) string {
+	return strings.ReplaceAll(escapeString(s), "$", `\$`)
+}
@@ -0,0 +1,223 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(phpGenerator{})
+}
+
+// PHP는 클래스명이 대소문자를 구분하지 않으므로 소문자로 비교
+var phpReservedTypes = newWordSet(`abstract and array as bool break callable case catch class clone const
+	continue declare default do echo else elseif empty enddeclare endfor endforeach endif endswitch endwhile enum
+	eval exit extends false final finally float fn for foreach function global goto if implements include
+	instanceof insteadof int interface isset iterable list match mixed namespace never new null object or parent
+	print private protected public readonly require return self static string switch throw trait true try unset
+	use var void while xor yield`)
+
+const phpDefaultNamespace = "Models"
+
+// PHP 생성기 - PSR-4 규칙에 따라 클래스마다 파일 1개 (<클래스명>.php)
+type phpGenerator struct{}
+
+func (phpGenerator) Name() string          { return "php" }
+func (phpGenerator) FileExtension() string { return ".php" }
+
+func (g phpGenerator) Generate(field models.Field, opts Options) ([]File, error) {
+	namespace := phpNamespace(opts.Namespace)
+	var files []File
+	for _, t := range outputTypes(field) {
+		_, isSchema := bundleRoot(field, t)
+		isRoot := typeName(t) == typeName(field) || isSchema
+		code := GeneratePHPClass(t, namespace, isRoot && HasKind(opts.OutputKinds, OutputJSON))
+		files = append(files, File{Path: phpTypeName(typeName(t)) + g.FileExtension(), Content: code})
+	}
+	return files, nil
+}
+
+// -namespace 값 → PHP 네임스페이스 ("acme::config", "acme.config" → "Acme\Config")
+func phpNamespace(ns string) string {
+	if ns == "" {
+		return phpDefaultNamespace
+	}
+	parts := strings.FieldsFunc(ns, func(r rune) bool {
+		return r == ':' || r == '.' || r == '/' || r == '\\'
+	})
+	for i, p := range parts {
+		parts[i] = phpTypeName(p)
+	}
+	return strings.Join(parts, `\`)
+}
+
+// PHP 프로퍼티 식별자 (camelCase, $변수명은 예약어 제한 없음)
+func phpIdent(name string) string {
+	id := camelCase(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	}
+	return id
+}
+
+// PHP 클래스명 (PascalCase, 예약어와 겹치면 _ 접미사)
+func phpTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case phpReservedTypes[strings.ToLower(id)]:
+		return id + "_"
+	}
+	return id
+}
+
+// PHP 프로퍼티 타입 (배열은 array, 알 수 없는 값은 mixed)
+func phpType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	switch {
+	case depth > 0:
+		if isNullableField(field) {
+			return "?array"
+		}
+		return "array"
+	case base == models.TypeObject:
+		return "mixed"
+	}
+	t := phpPrimitive(base)
+	if isNullableField(field) {
+		t = "?" + t
+	}
+	return t
+}
+
+// 기본 타입 → PHP 타입
+func phpPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "string"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt, models.TypeLong:
+		return "int"
+	case models.TypeFloat:
+		return "float"
+	case models.TypeObject:
+		return "mixed"
+	}
+	return phpTypeName(t)
+}
+
+// 배열 원소 타입 PHPDoc (예: Address[], int[][])
+func phpDocType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := phpPrimitive(base) + strings.Repeat("[]", depth)
+	if isNullableField(field) {
+		t += "|null"
+	}
+	return t
+}
+
+// PHP 클래스 1개 생성 (typed property + fromArray + JsonSerializable)
+func GeneratePHPClass(field models.Field, namespace string, withJSONFile bool) string {
+	var sb strings.Builder
+	name := phpTypeName(typeName(field))
+	idents := memberIdents(field.Children, phpIdent)
+
+	sb.WriteString("<?php\n\n")
+	sb.WriteString("declare(strict_types=1);\n\n")
+	sb.WriteString(fmt.Sprintf("namespace %s;\n\n", namespace))
+	sb.WriteString(fmt.Sprintf("final class %s implements \\JsonSerializable\n{\n", name))
+
+	// 프로퍼티
+	for i, c := range field.Children {
+		if depth, _ := fieldArrayType(c); depth > 0 {
+			sb.WriteString(fmt.Sprintf("    /** @var %s */\n", phpDocType(c)))
+		}
+		t := phpType(c)
+		if t == "mixed" || strings.HasPrefix(t, "?") {
+			sb.WriteString(fmt.Sprintf("    public %s $%s = null;\n", t, idents[i]))
+		} else {
+			sb.WriteString(fmt.Sprintf("    public %s $%s;\n", t, idents[i]))
+		}
+	}
+	if len(field.Children) > 0 {
+		sb.WriteString("\n")
+	}
+
+	// fromArray (json_decode(..., true) 결과로부터 생성)
+	sb.WriteString("    public static function fromArray(array $data): self\n    {\n")
+	sb.WriteString("        $obj = new self();\n")
+	for i, c := range field.Children {
+		depth, base := fieldArrayType(c)
+		value := fmt.Sprintf("$data[%s]", phpString(wireName(c)))
+		expr := phpFromArrayExpr(value, depth, base)
+		switch {
+		case expr == value && (isNullableField(c) || base == models.TypeObject && depth == 0):
+			expr = fmt.Sprintf("%s ?? null", value)
+		case isNullableField(c):
+			expr = fmt.Sprintf("isset(%s) ? %s : null", value, expr)
+		}
+		sb.WriteString(fmt.Sprintf("        $obj->%s = %s;\n", idents[i], expr))
+	}
+	sb.WriteString("        return $obj;\n    }\n\n")
+
+	// jsonSerialize (생략 가능한 키는 null이면 넣지 않음, 하위 객체는 json_encode가 재귀 처리)
+	sb.WriteString("    public function jsonSerialize(): object\n    {\n")
+	sb.WriteString("        $data = [\n")
+	for i, c := range field.Children {
+		if !c.Optional {
+			sb.WriteString(fmt.Sprintf("            %s => $this->%s,\n", phpString(wireName(c)), idents[i]))
+		}
+	}
+	sb.WriteString("        ];\n")
+	for i, c := range field.Children {
+		if c.Optional {
+			sb.WriteString(fmt.Sprintf("        if ($this->%s !== null) {\n", idents[i]))
+			sb.WriteString(fmt.Sprintf("            $data[%s] = $this->%s;\n        }\n", phpString(wireName(c)), idents[i]))
+		}
+	}
+	sb.WriteString("        return (object) $data;\n    }\n")
+
+	// JSON 파일 입출력 (루트 클래스만)
+	if withJSONFile {
+		sb.WriteString("\n    // 파일에서 JSON 읽기\n")
+		sb.WriteString("    public static function loadFromJsonFile(string $path): self\n    {\n")
+		sb.WriteString("        $json = file_get_contents($path);\n")
+		sb.WriteString("        if ($json === false) {\n")
+		sb.WriteString("            throw new \\RuntimeException(\"cannot read {$path}\");\n        }\n")
+		sb.WriteString("        return self::fromArray(json_decode($json, true, 512, JSON_THROW_ON_ERROR));\n    }\n\n")
+		sb.WriteString("    // JSON 파일로 저장\n")
+		sb.WriteString("    public function saveToJsonFile(string $path): void\n    {\n")
+		sb.WriteString("        $json = json_encode($this, JSON_PRETTY_PRINT | JSON_UNESCAPED_SLASHES | JSON_UNESCAPED_UNICODE | JSON_THROW_ON_ERROR);\n")
+		sb.WriteString("        if (file_put_contents($path, $json) === false) {\n")
+		sb.WriteString("            throw new \\RuntimeException(\"cannot write {$path}\");\n        }\n    }\n")
+	}
+
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// 배열 값 → PHP 값 변환식 (하위 클래스/클래스 배열은 fromArray로 변환)
+func phpFromArrayExpr(value string, depth int, base string) string {
+	if !isComplexType(base) {
+		return value
+	}
+	if depth > 0 {
+		item := phpFromArrayExpr("$item", depth-1, base)
+		return fmt.Sprintf("array_map(static fn($item) => %s, %s)", item, value)
+	}
+	return fmt.Sprintf("%s::fromArray(%s)", phpTypeName(base), value)
+}
+
+// PHP 작은따옴표 문자열 리터럴
+func phpString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
@@ -3,108 +3,168 @@ package generator
 import (
 	"fmt"
 	"strings"
+	"unicode"
 
 	"github.com/nosuk/CodeGenerator/models"
 )
 
+func init() {
+	Register(NewSingleFileGenerator("python", ".py", GeneratePythonCode))
+}
+
 // Python 코드 생성기 - JSON, XML 지원
 func GeneratePythonCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
 	var sb strings.Builder
 
 	// import문
 	sb.WriteString("import json\n")
+	sb.WriteString("from typing import Any, List, Optional\n")
 	sb.WriteString("import xml.etree.ElementTree as ET\n\n")
 
 	// 클래스 정의 (하위 클래스부터)
-	writePythonClasses(field, rootName, &sb)
+	writePythonClasses(field, &sb)
+
+	for _, root := range outputRoots(field, rootName) {
+		writePythonIO(pythonClassName(root.Name), &sb, outputKinds)
+	}
+
+	return sb.String()
+}
 
+// 루트 타입 1개의 파일 입출력 함수
+func writePythonIO(className string, sb *strings.Builder, outputKinds []OutputKind) {
 	// JSON 함수
 	if HasKind(outputKinds, OutputJSON) {
-		sb.WriteString(fmt.Sprintf("def load_%s_from_json_file(path):\n", to_snake_case(rootName)))
-		sb.WriteString(fmt.Sprintf("    with open(path, 'r', encoding='utf-8') as f:\n        data = json.load(f)\n    return %s.from_dict(data)\n\n", rootName))
-		sb.WriteString(fmt.Sprintf("def save_%s_to_json_file(path, obj):\n", to_snake_case(rootName)))
+		sb.WriteString(fmt.Sprintf("def load_%s_from_json_file(path):\n", to_snake_case(className)))
+		sb.WriteString(fmt.Sprintf("    with open(path, 'r', encoding='utf-8') as f:\n        data = json.load(f)\n    return %s.from_dict(data)\n\n", className))
+		sb.WriteString(fmt.Sprintf("def save_%s_to_json_file(path, obj):\n", to_snake_case(className)))
 		sb.WriteString("    with open(path, 'w', encoding='utf-8') as f:\n        json.dump(obj.to_dict(), f, ensure_ascii=False, indent=2)\n\n")
 	}
 
 	// XML 함수(간단 버전: xml.etree.ElementTree 이용)
 	if HasKind(outputKinds, OutputXML) {
 		sb.WriteString(fmt.Sprintf("# XML 지원은 기본 dict 변환을 가정한 예시, 실전용 구현은 확장 필요\n"))
-		sb.WriteString(fmt.Sprintf("def load_%s_from_xml_file(path):\n", to_snake_case(rootName)))
+		sb.WriteString(fmt.Sprintf("def load_%s_from_xml_file(path):\n", to_snake_case(className)))
 		sb.WriteString("    tree = ET.parse(path)\n    root = tree.getroot()\n    # TODO: ElementTree → dict → 클래스 변환 구현 필요\n\n")
-		sb.WriteString(fmt.Sprintf("def save_%s_to_xml_file(path, obj):\n", to_snake_case(rootName)))
+		sb.WriteString(fmt.Sprintf("def save_%s_to_xml_file(path, obj):\n", to_snake_case(className)))
 		sb.WriteString("    # TODO: 클래스 → dict → ElementTree 변환 구현 필요\n    pass\n\n")
 	}
-
-	return sb.String()
 }
 
-// ... 이하 writePythonClasses, to_snake_case, HasKind 함수는 동일
-func writePythonClasses(field models.Field, rootName string, sb *strings.Builder) {
-	for _, child := range field.Children {
-		if child.IsComplex {
-			writePythonClasses(child, rootName, sb)
-			if child.Name != rootName {
-				writePythonClass(child, sb)
-			}
-		}
+// 하위 클래스(같은 타입은 한 번만) 먼저, 마지막에 루트 클래스 (루트 묶음이면 생략)
+func writePythonClasses(field models.Field, sb *strings.Builder) {
+	for _, child := range collectComplexTypes(field) {
+		writePythonClass(child, sb)
 	}
-	if field.Name == rootName {
+	if !field.Bundle {
 		writePythonClass(field, sb)
 	}
 }
 
 func writePythonClass(field models.Field, sb *strings.Builder) {
-	sb.WriteString(fmt.Sprintf("class %s:\n", field.Name))
+	className := pythonClassName(typeName(field))
+	idents := memberIdents(field.Children, pythonIdent)
+
+	sb.WriteString(fmt.Sprintf("class %s:\n", className))
 	sb.WriteString("    def __init__(self")
-	for _, c := range field.Children {
-		sb.WriteString(fmt.Sprintf(", %s=None", to_snake_case(c.Name)))
+	for i, c := range field.Children {
+		sb.WriteString(fmt.Sprintf(", %s: %s = None", idents[i], pythonHint(c)))
 	}
 	sb.WriteString("):\n")
-	for _, c := range field.Children {
-		sb.WriteString(fmt.Sprintf("        self.%s = %s\n", to_snake_case(c.Name), to_snake_case(c.Name)))
+	for i := range field.Children {
+		sb.WriteString(fmt.Sprintf("        self.%s = %s\n", idents[i], idents[i]))
 	}
 	sb.WriteString("\n")
 
 	sb.WriteString("    @staticmethod\n")
 	sb.WriteString("    def from_dict(obj):\n")
 	sb.WriteString("        if obj is None: return None\n")
-	sb.WriteString(fmt.Sprintf("        return %s(\n", field.Name))
+	sb.WriteString(fmt.Sprintf("        return %s(\n", className))
 	for i, c := range field.Children {
 		if c.IsComplex {
 			if c.IsArray {
-				sb.WriteString(fmt.Sprintf("            %s=[%s.from_dict(x) for x in obj.get('%s', [])]%s\n", to_snake_case(c.Name), c.Name, c.Name, if_comma(i, field.Children)))
+				sb.WriteString(fmt.Sprintf("            %s=[%s.from_dict(x) for x in obj.get(%s, [])]%s\n", idents[i], pythonClassName(typeName(c)), pythonString(wireName(c)), if_comma(i, field.Children)))
 			} else {
-				sb.WriteString(fmt.Sprintf("            %s=%s.from_dict(obj.get('%s'))%s\n", to_snake_case(c.Name), c.Name, c.Name, if_comma(i, field.Children)))
+				sb.WriteString(fmt.Sprintf("            %s=%s.from_dict(obj.get(%s))%s\n", idents[i], pythonClassName(typeName(c)), pythonString(wireName(c)), if_comma(i, field.Children)))
 			}
 		} else {
-			sb.WriteString(fmt.Sprintf("            %s=obj.get('%s')%s\n", to_snake_case(c.Name), c.Name, if_comma(i, field.Children)))
+			sb.WriteString(fmt.Sprintf("            %s=obj.get(%s)%s\n", idents[i], pythonString(wireName(c)), if_comma(i, field.Children)))
 		}
 	}
 	sb.WriteString("        )\n\n")
 
 	sb.WriteString("    def to_dict(self):\n")
 	sb.WriteString("        result = {}\n")
-	for _, c := range field.Children {
+	for i, c := range field.Children {
 		if c.IsComplex && c.IsArray {
-			sb.WriteString(fmt.Sprintf("        result['%s'] = [x.to_dict() for x in self.%s] if self.%s is not None else []\n", c.Name, to_snake_case(c.Name), to_snake_case(c.Name)))
+			sb.WriteString(fmt.Sprintf("        result[%s] = [x.to_dict() for x in self.%s] if self.%s is not None else []\n", pythonString(wireName(c)), idents[i], idents[i]))
 		} else if c.IsComplex {
-			sb.WriteString(fmt.Sprintf("        result['%s'] = self.%s.to_dict() if self.%s else None\n", c.Name, to_snake_case(c.Name), to_snake_case(c.Name)))
+			sb.WriteString(fmt.Sprintf("        result[%s] = self.%s.to_dict() if self.%s else None\n", pythonString(wireName(c)), idents[i], idents[i]))
 		} else {
-			sb.WriteString(fmt.Sprintf("        result['%s'] = self.%s\n", c.Name, to_snake_case(c.Name)))
+			sb.WriteString(fmt.Sprintf("        result[%s] = self.%s\n", pythonString(wireName(c)), idents[i]))
 		}
 	}
 	sb.WriteString("        return result\n\n")
 }
 
+// 타입 힌트 (배열은 List[...])
+func pythonType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := pythonPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List[%s]", t)
+	}
+	return t
+}
+
+// 값이 없을 수 있는 필드는 Optional[...]
+func pythonHint(field models.Field) string {
+	if isNullableField(field) {
+		return fmt.Sprintf("Optional[%s]", pythonType(field))
+	}
+	return pythonType(field)
+}
+
+// 기본 타입 → Python 타입 (클래스명은 그대로)
+func pythonPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "str"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt, models.TypeLong:
+		return "int"
+	case models.TypeFloat:
+		return "float"
+	case models.TypeObject:
+		return "Any"
+	}
+	return fmt.Sprintf("'%s'", pythonClassName(t))
+}
+
+// 작은따옴표 문자열 리터럴 (dict 키)
+func pythonString(s string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(s) + "'"
+}
+
+// snake_case 변환 ("userId" → "user_id", "IPAddress" → "ip_address", "postal-code" → "postal_code")
 func to_snake_case(s string) string {
-	var out []rune
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			out = append(out, '_')
+	var words []string
+	for _, w := range models.SplitWords(s) {
+		runes := []rune(w)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, cur := runes[i-1], runes[i]
+			// 소문자/숫자 뒤 대문자, 또는 약어 끝(대문자 뒤 대문자+소문자)에서 분리
+			acronymEnd := unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev) || acronymEnd) {
+				words = append(words, string(runes[start:i]))
+				start = i
+			}
 		}
-		out = append(out, r)
+		words = append(words, string(runes[start:]))
 	}
-	return strings.ToLower(string(out))
+	return strings.ToLower(strings.Join(words, "_"))
 }
 
 func if_comma(i int, l []models.Field) string {
@@ -0,0 +1,78 @@
+package generator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+// 생성 결과 파일 1개 (언어별 출력 디렉토리 기준 상대 경로)
+type File struct {
+	Path    string
+	Content string
+}
+
+// 코드 생성 옵션
+type Options struct {
+	RootName    string       // 루트 타입 이름
+	BaseName    string       // 출력 파일 기본 이름 (입력 파일명)
+	Namespace   string       // 네임스페이스/패키지 (지원 언어만, 비었으면 생략)
+	OutputKinds []OutputKind // 생성할 입출력 함수 종류 (비었으면 전부)
+}
+
+// 언어별 코드 생성기
+// 사내 전용 타겟은 이 인터페이스를 구현하고 init()에서 Register 하면 CLI에 바로 노출됨
+type Generator interface {
+	Name() string          // -lang 값 (예: "csharp")
+	FileExtension() string // 대표 확장자 (예: ".cs")
+	Generate(field models.Field, opts Options) ([]File, error)
+}
+
+var registry = map[string]Generator{}
+
+// 생성기 등록 (이름 중복 시 panic)
+func Register(g Generator) {
+	if _, exists := registry[g.Name()]; exists {
+		panic(fmt.Sprintf("generator: %s 생성기가 이미 등록되어 있습니다", g.Name()))
+	}
+	registry[g.Name()] = g
+}
+
+// 이름으로 생성기 조회
+func Lookup(name string) (Generator, bool) {
+	g, ok := registry[name]
+	return g, ok
+}
+
+// 등록된 언어 이름 목록 (이름순)
+func Names() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// GenerateXxxCode 형태의 단일 파일 생성 함수
+type CodeFunc func(field models.Field, rootName string, outputKinds ...OutputKind) string
+
+// 단일 파일 생성 함수를 Generator로 감싸기
+func NewSingleFileGenerator(name, ext string, fn CodeFunc) Generator {
+	return singleFileGenerator{name: name, ext: ext, fn: fn}
+}
+
+type singleFileGenerator struct {
+	name string
+	ext  string
+	fn   CodeFunc
+}
+
+func (g singleFileGenerator) Name() string          { return g.name }
+func (g singleFileGenerator) FileExtension() string { return g.ext }
+
+func (g singleFileGenerator) Generate(field models.Field, opts Options) ([]File, error) {
+	code := g.fn(field, opts.RootName, opts.OutputKinds...)
+	return []File{{Path: opts.BaseName + g.ext, Content: code}}, nil
+}
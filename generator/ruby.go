@@ -0,0 +1,192 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("ruby", ".rb", GenerateRubyCode))
+}
+
+var (
+	rubyKeywords = newWordSet(`alias and begin break case class def defined do else elsif end ensure false for if
+		in module next nil not or redo rescue retry return self super then true undef unless until when while yield`)
+	// 표준 클래스와 겹치는 클래스명
+	rubyReservedTypes = newWordSet(`Array Class Comparable Data Date Float Hash Integer JSON Kernel Module Object
+		Set String Struct Symbol Time`)
+	// Object 메서드/생성되는 메서드와 겹치는 속성명
+	rubyMemberNames = []string{"hash", "object_id", "to_h", "from_h"}
+)
+
+// Ruby 속성 식별자 (snake_case, 예약어는 _ 접미사)
+func rubyIdent(name string) string {
+	id := to_snake_case(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case rubyKeywords[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Ruby 클래스명 (상수이므로 대문자로 시작해야 함, 숫자/한글 등은 X 접두사)
+func rubyClassName(name string) string {
+	id := pascalCase(name)
+	if id == "" {
+		return "Type"
+	}
+	if r, _ := utf8.DecodeRuneInString(id); !unicode.IsUpper(r) {
+		id = "X" + id
+	}
+	if rubyReservedTypes[id] {
+		return id + "_"
+	}
+	return id
+}
+
+// YARD 타입 표기 (예: Array<Address>, String, nil)
+func rubyDocType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := rubyPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("Array<%s>", t)
+	}
+	if isNullableField(field) && t != "Object" {
+		t += ", nil"
+	}
+	return t
+}
+
+// 기본 타입 → Ruby 클래스
+func rubyPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "Boolean"
+	case models.TypeInt, models.TypeLong:
+		return "Integer"
+	case models.TypeFloat:
+		return "Float"
+	case models.TypeObject:
+		return "Object"
+	}
+	return rubyClassName(t)
+}
+
+// Ruby 코드 생성기 - from_h/to_h를 가진 일반 클래스
+func GenerateRubyCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	sb.WriteString("require 'json'\n\n")
+
+	// 클래스 정의 (하위 클래스부터, Python과 같은 순서)
+	writeRubyClasses(field, &sb)
+
+	// JSON 함수 (XML은 표준 매핑이 없어 생략)
+	if HasKind(outputKinds, OutputJSON) {
+		for i, r := range outputRoots(field, rootName) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			fn := to_snake_case(r.Name)
+			root := rubyClassName(r.Name)
+			sb.WriteString("# 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("def load_%s_from_json_file(path)\n", fn))
+			sb.WriteString(fmt.Sprintf("  %s.from_h(JSON.parse(File.read(path, encoding: 'utf-8')))\nend\n\n", root))
+			sb.WriteString("# JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("def save_%s_to_json_file(path, obj)\n", fn))
+			sb.WriteString("  File.write(path, JSON.pretty_generate(obj.to_h), encoding: 'utf-8')\nend\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// 하위 클래스(같은 타입은 한 번만) 먼저, 마지막에 루트 클래스 (루트 묶음이면 생략)
+func writeRubyClasses(field models.Field, sb *strings.Builder) {
+	for _, t := range outputTypes(field) {
+		writeRubyClass(t, sb)
+	}
+}
+
+func writeRubyClass(field models.Field, sb *strings.Builder) {
+	className := rubyClassName(typeName(field))
+	idents := memberIdents(field.Children, rubyIdent, rubyMemberNames...)
+
+	sb.WriteString(fmt.Sprintf("class %s\n", className))
+	for i, c := range field.Children {
+		sb.WriteString(fmt.Sprintf("  # @return [%s]\n", rubyDocType(c)))
+		sb.WriteString(fmt.Sprintf("  attr_accessor :%s\n\n", idents[i]))
+	}
+
+	// 생성자 (키워드 인자)
+	args := make([]string, len(idents))
+	for i, id := range idents {
+		args[i] = id + ": nil"
+	}
+	sb.WriteString(fmt.Sprintf("  def initialize(%s)\n", strings.Join(args, ", ")))
+	for _, id := range idents {
+		sb.WriteString(fmt.Sprintf("    @%s = %s\n", id, id))
+	}
+	sb.WriteString("  end\n\n")
+
+	// from_h (JSON.parse 결과 → 객체)
+	sb.WriteString("  def self.from_h(hash)\n")
+	sb.WriteString("    return nil if hash.nil?\n\n")
+	sb.WriteString("    new(\n")
+	for i, c := range field.Children {
+		depth, base := fieldArrayType(c)
+		value := fmt.Sprintf("hash[%s]", pythonString(wireName(c)))
+		sb.WriteString(fmt.Sprintf("      %s: %s%s\n", idents[i], rubyFromHashExpr(value, depth, base, 1), if_comma(i, field.Children)))
+	}
+	sb.WriteString("    )\n  end\n\n")
+
+	// to_h (생략 가능한 키는 nil이면 넣지 않음)
+	sb.WriteString("  def to_h\n")
+	sb.WriteString("    result = {\n")
+	for i, c := range field.Children {
+		depth, base := fieldArrayType(c)
+		value := rubyToHashExpr("@"+idents[i], depth, base, 1)
+		sb.WriteString(fmt.Sprintf("      %s => %s%s\n", pythonString(wireName(c)), value, if_comma(i, field.Children)))
+	}
+	sb.WriteString("    }\n")
+	for i, c := range field.Children {
+		if c.Optional {
+			sb.WriteString(fmt.Sprintf("    result.delete(%s) if @%s.nil?\n", pythonString(wireName(c)), idents[i]))
+		}
+	}
+	sb.WriteString("    result\n  end\nend\n\n")
+}
+
+// Hash 값 → Ruby 값 변환식 (하위 클래스는 from_h, 배열은 원소마다 재귀)
+func rubyFromHashExpr(value string, depth int, base string, level int) string {
+	if !isComplexType(base) {
+		return value
+	}
+	if depth > 0 {
+		item := fmt.Sprintf("x%d", level)
+		return fmt.Sprintf("%s&.map { |%s| %s }", value, item, rubyFromHashExpr(item, depth-1, base, level+1))
+	}
+	return fmt.Sprintf("%s.from_h(%s)", rubyClassName(base), value)
+}
+
+// Ruby 값 → Hash 값 변환식 (하위 클래스는 to_h)
+func rubyToHashExpr(value string, depth int, base string, level int) string {
+	if !isComplexType(base) {
+		return value
+	}
+	if depth > 0 {
+		item := fmt.Sprintf("x%d", level)
+		return fmt.Sprintf("%s&.map { |%s| %s }", value, item, rubyToHashExpr(item, depth-1, base, level+1))
+	}
+	return value + "&.to_h"
+}
@@ -0,0 +1,345 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("rust", ".rs", GenerateRustCode))
+}
+
+var (
+	rustKeywords = newWordSet(`as async await break const continue crate dyn else enum extern false fn for if impl
+		in let loop match mod move mut pub ref return self static struct super trait true type unsafe use where
+		while abstract become box do final macro override priv try typeof unsized virtual yield`)
+	// r#로 감쌀 수 없는 키워드
+	rustNoRawKeywords = newWordSet(`crate self super`)
+	// 표준 타입과 겹치는 struct명
+	rustReservedTypes = newWordSet(`Box Option Result Self String Value Vec`)
+)
+
+// Rust 필드 식별자 (snake_case, 예약어는 r#)
+func rustIdent(name string) string {
+	id := to_snake_case(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case rustNoRawKeywords[id]:
+		return id + "_"
+	case rustKeywords[id]:
+		return "r#" + id
+	}
+	return id
+}
+
+// Rust struct명 (PascalCase)
+func rustTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case rustReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Rust 타입 변환 (배열은 Vec<>)
+func rustType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := rustPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("Vec<%s>", t)
+	}
+	return t
+}
+
+// 기본 타입 → Rust 타입 (알 수 없는 값은 serde_json::Value)
+func rustPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "bool"
+	case models.TypeInt:
+		return "i32"
+	case models.TypeLong:
+		return "i64"
+	case models.TypeFloat:
+		return "f64"
+	case models.TypeObject:
+		return "serde_json::Value"
+	}
+	return rustTypeName(t)
+}
+
+// XML 래퍼 배열(<Employees><Employee/>...</Employees>) 식별 키 (아이템 요소명 + 아이템 타입)
+func rustWrapperKey(field models.Field) string {
+	return field.XMLItemName + "\x00" + rustType(field)
+}
+
+// XML 래퍼 배열인지 (XML 입력에서 래퍼 요소로 감싸진 배열)
+func isRustXMLWrapper(field models.Field) bool {
+	return field.IsArray && field.XMLItemName != ""
+}
+
+// XML 래퍼 배열을 담는 struct명 (키별로 1개)
+// serde는 래퍼 요소를 건너뛸 수 없으므로 아이템 목록을 가진 struct를 따로 생성
+// 기본은 <아이템>List, 아이템 타입이 다른 래퍼와 겹치면 <부모><필드>List
+func rustWrapperNames(types []models.Field) map[string]string {
+	used := map[string]bool{}
+	for _, t := range types {
+		used[rustTypeName(typeName(t))] = true
+	}
+	names := map[string]string{}
+	for _, t := range types {
+		for _, c := range t.Children {
+			key := rustWrapperKey(c)
+			if !isRustXMLWrapper(c) || names[key] != "" {
+				continue
+			}
+			name := rustTypeName(c.XMLItemName) + "List"
+			if used[name] {
+				name = rustTypeName(typeName(t)) + pascalCase(c.Name) + "List"
+			}
+			candidate := name
+			for i := 2; used[candidate]; i++ {
+				candidate = fmt.Sprintf("%s%d", name, i)
+			}
+			used[candidate] = true
+			names[key] = candidate
+		}
+	}
+	return names
+}
+
+// quick-xml 규칙의 serde 이름 (속성은 @, 텍스트는 $text, 없으면 JSON과 같은 이름)
+func rustXMLName(field models.Field) string {
+	switch field.XMLKind {
+	case models.XMLAttribute:
+		return "@" + wireName(field)
+	case models.XMLCharData, models.XMLInnerXML:
+		return "$text"
+	}
+	return wireName(field)
+}
+
+// JSON과 XML의 serde 모양이 다른지 (속성/텍스트/래퍼 배열이 있으면 XML 전용 struct 필요)
+func needsRustXMLTypes(types []models.Field) bool {
+	for _, t := range types {
+		for _, c := range t.Children {
+			if rustXMLName(c) != wireName(c) || isRustXMLWrapper(c) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Rust 코드 생성기 - serde derive struct + serde_json/quick-xml 입출력 함수
+// struct는 JSON 모양, XML 모양이 다르면 xml 모듈에 XML 전용 struct와 From 변환을 따로 생성
+func GenerateRustCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	sb.WriteString("use serde::{Deserialize, Serialize};\n")
+	sb.WriteString("use std::error::Error;\n")
+	sb.WriteString("use std::fs;\n\n")
+
+	// struct 정의 (하위 struct 먼저)
+	types := outputTypes(field)
+	for _, t := range types {
+		writeRustStruct(t, false, nil, &sb)
+	}
+
+	hasXML := HasKind(outputKinds, OutputXML)
+	xmlTypes := hasXML && needsRustXMLTypes(types)
+	if xmlTypes {
+		writeRustXMLModule(types, &sb)
+	}
+
+	for i, r := range outputRoots(field, rootName) {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		root := rustTypeName(r.Name)
+		fn := to_snake_case(r.Name)
+
+		// JSON 입출력
+		if HasKind(outputKinds, OutputJSON) {
+			sb.WriteString("// 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("pub fn load_%s_from_json_file(path: &str) -> Result<%s, Box<dyn Error>> {\n", fn, root))
+			sb.WriteString("    let data = fs::read_to_string(path)?;\n")
+			sb.WriteString("    Ok(serde_json::from_str(&data)?)\n}\n\n")
+			sb.WriteString("// JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("pub fn save_%s_to_json_file(path: &str, value: &%s) -> Result<(), Box<dyn Error>> {\n", fn, root))
+			sb.WriteString("    let data = serde_json::to_string_pretty(value)?;\n")
+			sb.WriteString("    fs::write(path, data)?;\n")
+			sb.WriteString("    Ok(())\n}\n\n")
+		}
+
+		// XML 입출력 (XML 전용 struct가 있으면 읽은 뒤/쓰기 전에 변환)
+		if hasXML {
+			sb.WriteString("// 파일에서 XML 읽기\n")
+			sb.WriteString(fmt.Sprintf("pub fn load_%s_from_xml_file(path: &str) -> Result<%s, Box<dyn Error>> {\n", fn, root))
+			sb.WriteString("    let data = fs::read_to_string(path)?;\n")
+			if xmlTypes {
+				sb.WriteString(fmt.Sprintf("    let value: xml::%s = quick_xml::de::from_str(&data)?;\n", root))
+				sb.WriteString("    Ok(value.into())\n}\n\n")
+			} else {
+				sb.WriteString("    Ok(quick_xml::de::from_str(&data)?)\n}\n\n")
+			}
+			sb.WriteString("// XML 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("pub fn save_%s_to_xml_file(path: &str, value: &%s) -> Result<(), Box<dyn Error>> {\n", fn, root))
+			if xmlTypes {
+				sb.WriteString(fmt.Sprintf("    let value = xml::%s::from(value.clone());\n", root))
+				sb.WriteString(fmt.Sprintf("    let data = quick_xml::se::to_string_with_root(\"%s\", &value)?;\n", escapeString(wireName(r.Field))))
+			} else {
+				sb.WriteString(fmt.Sprintf("    let data = quick_xml::se::to_string_with_root(\"%s\", value)?;\n", escapeString(wireName(r.Field))))
+			}
+			sb.WriteString("    fs::write(path, data)?;\n")
+			sb.WriteString("    Ok(())\n}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+// struct 1개 생성 (xml이면 quick-xml 이름과 래퍼 배열 struct 사용, 들여쓰기는 xml 모듈 기준)
+func writeRustStruct(field models.Field, xml bool, wrappers map[string]string, sb *strings.Builder) {
+	indent := ""
+	if xml {
+		indent = "    "
+	}
+	sb.WriteString(indent + "#[derive(Serialize, Deserialize, Debug, Clone)]\n")
+	sb.WriteString(fmt.Sprintf("%spub struct %s {\n", indent, rustTypeName(typeName(field))))
+	idents := memberIdents(field.Children, rustIdent)
+	for i, c := range field.Children {
+		t := rustType(c)
+		serdeName := wireName(c)
+		if xml {
+			serdeName = rustXMLName(c)
+			if isRustXMLWrapper(c) {
+				t = wrappers[rustWrapperKey(c)]
+			}
+		}
+
+		var attrs []string
+		if serdeName != strings.TrimPrefix(idents[i], "r#") {
+			attrs = append(attrs, fmt.Sprintf("rename = \"%s\"", escapeString(serdeName)))
+		}
+		if isNullableField(c) {
+			t = fmt.Sprintf("Option<%s>", t)
+		}
+		if c.Optional {
+			attrs = append(attrs, "default", "skip_serializing_if = \"Option::is_none\"")
+		}
+		if len(attrs) > 0 {
+			sb.WriteString(fmt.Sprintf("%s    #[serde(%s)]\n", indent, strings.Join(attrs, ", ")))
+		}
+		sb.WriteString(fmt.Sprintf("%s    pub %s: %s,\n", indent, idents[i], t))
+	}
+	sb.WriteString(indent + "}\n\n")
+}
+
+// XML 전용 struct 모듈 (같은 이름의 struct + 래퍼 배열 struct + 양방향 From 변환)
+func writeRustXMLModule(types []models.Field, sb *strings.Builder) {
+	wrappers := rustWrapperNames(types)
+
+	sb.WriteString("// XML 모양 struct (quick-xml: 속성은 @, 텍스트는 $text, 래퍼 요소는 별도 struct)\n")
+	sb.WriteString("pub mod xml {\n")
+	sb.WriteString("    use serde::{Deserialize, Serialize};\n\n")
+
+	// 래퍼 배열 struct는 한 번만
+	written := map[string]bool{}
+	for _, t := range types {
+		for _, c := range t.Children {
+			if name := wrappers[rustWrapperKey(c)]; isRustXMLWrapper(c) && !written[name] {
+				written[name] = true
+				writeRustWrapper(c, name, sb)
+			}
+		}
+		writeRustStruct(t, true, wrappers, sb)
+	}
+
+	for _, t := range types {
+		writeRustFrom(t, true, wrappers, sb)
+		writeRustFrom(t, false, wrappers, sb)
+	}
+	sb.WriteString("}\n\n")
+}
+
+// XML 래퍼 배열 struct
+func writeRustWrapper(field models.Field, name string, sb *strings.Builder) {
+	sb.WriteString("    #[derive(Serialize, Deserialize, Debug, Clone, Default)]\n")
+	sb.WriteString(fmt.Sprintf("    pub struct %s {\n", name))
+	sb.WriteString(fmt.Sprintf("        #[serde(rename = \"%s\", default)]\n", escapeString(field.XMLItemName)))
+	sb.WriteString(fmt.Sprintf("        pub items: %s,\n", rustType(field)))
+	sb.WriteString("    }\n\n")
+}
+
+// JSON 모양 struct ↔ XML 모양 struct 변환 (toXML이면 super::T → T)
+func writeRustFrom(field models.Field, toXML bool, wrappers map[string]string, sb *strings.Builder) {
+	name := rustTypeName(typeName(field))
+	from, to := name, "super::"+name
+	if toXML {
+		from, to = to, from
+	}
+	idents := memberIdents(field.Children, rustIdent)
+	value := "value"
+	if len(field.Children) == 0 {
+		value = "_value"
+	}
+
+	sb.WriteString(fmt.Sprintf("    impl From<%s> for %s {\n", from, to))
+	sb.WriteString(fmt.Sprintf("        fn from(%s: %s) -> Self {\n", value, from))
+	sb.WriteString("            Self {\n")
+	for i, c := range field.Children {
+		expr := rustFromExpr(c, "value."+idents[i], toXML, wrappers)
+		sb.WriteString(fmt.Sprintf("                %s: %s,\n", idents[i], expr))
+	}
+	sb.WriteString("            }\n        }\n    }\n\n")
+}
+
+// 필드 값 1개 변환식 (기본 타입은 그대로, struct는 into, 래퍼 배열은 items로 감싸거나 꺼냄)
+func rustFromExpr(field models.Field, value string, toXML bool, wrappers map[string]string) string {
+	depth, base := fieldArrayType(field)
+	convert := func(v string) string {
+		expr := rustConvertValue(v, depth, isComplexType(base), 0)
+		if !isRustXMLWrapper(field) {
+			return expr
+		}
+		if toXML {
+			return fmt.Sprintf("%s { items: %s }", wrappers[rustWrapperKey(field)], expr)
+		}
+		return rustConvertValue(v+".items", depth, isComplexType(base), 0)
+	}
+	if !isNullableField(field) {
+		return convert(value)
+	}
+	if expr := convert("x"); expr != "x" {
+		return fmt.Sprintf("%s.map(|x| %s)", value, expr)
+	}
+	return value
+}
+
+// struct 값 변환식 (배열은 원소마다, 중첩 배열은 단계마다 변수명 구분)
+func rustConvertValue(value string, depth int, complex bool, level int) string {
+	switch {
+	case !complex:
+		return value
+	case depth == 0:
+		return value + ".into()"
+	}
+	v := fmt.Sprintf("v%d", level)
+	if level == 0 {
+		v = "v"
+	}
+	return fmt.Sprintf("%s.into_iter().map(|%s| %s).collect()", value, v, rustConvertValue(v, depth-1, complex, level+1))
+}
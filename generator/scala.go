@@ -0,0 +1,190 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("scala", ".scala", GenerateScalaCode))
+}
+
+var (
+	scalaKeywords = newWordSet(`abstract case catch class def do else enum export extends false final finally for
+		forSome given if implicit import lazy match new null object override package private protected return
+		sealed super then this throw trait try true type val var while with yield`)
+	// 표준 타입/circe 타입과 겹치는 클래스명
+	scalaReservedTypes = newWordSet(`Any AnyRef AnyVal Boolean Decoder Double Either Encoder Int Json List Long Map
+		Nothing Null Option Seq Set String Unit Vector`)
+	// case class가 자동 생성하는 멤버와 겹치는 필드명
+	scalaMemberNames = []string{"copy", "equals", "hashCode", "productArity", "productElement", "productIterator",
+		"productPrefix", "toString"}
+)
+
+// Scala 필드 식별자 (camelCase, 예약어/숫자 시작은 백틱)
+func scalaIdent(name string) string {
+	id := camelCase(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id) || scalaKeywords[id]:
+		return "`" + id + "`"
+	}
+	return id
+}
+
+// Scala 클래스명 (PascalCase, 표준 타입과 겹치면 _ 접미사)
+func scalaTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case scalaReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Scala 타입 변환 (배열은 List[T], 값이 없을 수 있으면 Option[T])
+// 알 수 없는 값(Json)은 null 자체를 담을 수 있으므로 Option으로 감싸지 않음
+func scalaType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := scalaPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("List[%s]", t)
+	}
+	if isNullableField(field) && t != "Json" {
+		t = fmt.Sprintf("Option[%s]", t)
+	}
+	return t
+}
+
+// 기본 타입 → Scala 타입 (알 수 없는 값은 circe Json)
+func scalaPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "Boolean"
+	case models.TypeInt:
+		return "Int"
+	case models.TypeLong:
+		return "Long"
+	case models.TypeFloat:
+		return "Double"
+	case models.TypeObject:
+		return "Json"
+	}
+	return scalaTypeName(t)
+}
+
+// Scala 코드 생성기 - final case class + 동반 객체의 circe Encoder/Decoder
+func GenerateScalaCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+	hasJSON := HasKind(outputKinds, OutputJSON)
+
+	if hasJSON {
+		sb.WriteString("import io.circe.parser.decode\n")
+	}
+	sb.WriteString("import io.circe.syntax._\n")
+	sb.WriteString("import io.circe.{Decoder, Encoder, Json}\n")
+	if hasJSON {
+		sb.WriteString("import java.nio.charset.StandardCharsets\n")
+		sb.WriteString("import java.nio.file.{Files, Paths}\n")
+	}
+	sb.WriteString("\n")
+
+	// case class + 동반 객체 (하위 클래스 먼저)
+	for _, t := range outputTypes(field) {
+		writeScalaClass(t, &sb)
+	}
+
+	// JSON 파일 입출력 (circe에는 XML 포맷이 없어 생략)
+	if hasJSON {
+		for i, r := range outputRoots(field, rootName) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			root := scalaTypeName(r.Name)
+			sb.WriteString(fmt.Sprintf("object %sIO {\n", root))
+			sb.WriteString("  // 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("  def loadFromJsonFile(path: String): Either[io.circe.Error, %s] =\n", root))
+			sb.WriteString(fmt.Sprintf("    decode[%s](new String(Files.readAllBytes(Paths.get(path)), StandardCharsets.UTF_8))\n\n", root))
+			sb.WriteString("  // JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("  def saveToJsonFile(path: String, value: %s): Unit = {\n", root))
+			sb.WriteString("    Files.write(Paths.get(path), value.asJson.spaces2.getBytes(StandardCharsets.UTF_8))\n")
+			sb.WriteString("    ()\n  }\n}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func writeScalaClass(field models.Field, sb *strings.Builder) {
+	name := scalaTypeName(typeName(field))
+	idents := memberIdents(field.Children, scalaIdent, scalaMemberNames...)
+
+	// case class
+	if len(field.Children) == 0 {
+		sb.WriteString(fmt.Sprintf("final case class %s()\n\n", name))
+	} else {
+		sb.WriteString(fmt.Sprintf("final case class %s(\n", name))
+		for i, c := range field.Children {
+			sb.WriteString(fmt.Sprintf("  %s: %s%s\n", idents[i], scalaType(c), if_comma(i, field.Children)))
+		}
+		sb.WriteString(")\n\n")
+	}
+
+	sb.WriteString(fmt.Sprintf("object %s {\n", name))
+
+	// Decoder (원본 키 → 필드, Option은 키 누락/null 모두 None)
+	sb.WriteString(fmt.Sprintf("  implicit val decoder: Decoder[%s] = Decoder.instance { c =>\n", name))
+	if len(field.Children) == 0 {
+		sb.WriteString(fmt.Sprintf("    Right(%s())\n  }\n\n", name))
+	} else {
+		sb.WriteString("    for {\n")
+		args := make([]string, len(field.Children))
+		for i, c := range field.Children {
+			args[i] = fmt.Sprintf("f%d", i+1)
+			decoded := fmt.Sprintf("c.downField(\"%s\").as[%s]", escapeString(wireName(c)), scalaType(c))
+			if scalaType(c) == "Json" {
+				// 키가 없어도 실패하지 않도록 Json.Null로 대체
+				decoded = fmt.Sprintf("c.downField(\"%s\").as[Option[Json]].map(_.getOrElse(Json.Null))", escapeString(wireName(c)))
+			}
+			sb.WriteString(fmt.Sprintf("      %s <- %s\n", args[i], decoded))
+		}
+		sb.WriteString(fmt.Sprintf("    } yield %s(%s)\n  }\n\n", name, strings.Join(args, ", ")))
+	}
+
+	// Encoder (필드 → 원본 키, 생략 가능한 키는 None이면 넣지 않음)
+	sb.WriteString(fmt.Sprintf("  implicit val encoder: Encoder[%s] = Encoder.instance { v =>\n", name))
+	hasOptional := false
+	for _, c := range field.Children {
+		if c.Optional && scalaType(c) != "Json" {
+			hasOptional = true
+		}
+	}
+	if hasOptional {
+		sb.WriteString("    Json.fromFields(List(\n")
+		for i, c := range field.Children {
+			key := escapeString(wireName(c))
+			if c.Optional && scalaType(c) != "Json" {
+				sb.WriteString(fmt.Sprintf("      v.%s.map(x => \"%s\" -> x.asJson)%s\n", idents[i], key, if_comma(i, field.Children)))
+			} else {
+				sb.WriteString(fmt.Sprintf("      Some(\"%s\" -> v.%s.asJson)%s\n", key, idents[i], if_comma(i, field.Children)))
+			}
+		}
+		sb.WriteString("    ).flatten)\n")
+	} else {
+		sb.WriteString("    Json.obj(\n")
+		for i, c := range field.Children {
+			sb.WriteString(fmt.Sprintf("      \"%s\" -> v.%s.asJson%s\n", escapeString(wireName(c)), idents[i], if_comma(i, field.Children)))
+		}
+		sb.WriteString("    )\n")
+	}
+	sb.WriteString("  }\n}\n\n")
+}
@@ -0,0 +1,187 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("swift", ".swift", GenerateSwiftCode))
+}
+
+var (
+	swiftKeywords = newWordSet(`associatedtype class deinit enum extension fileprivate func import init inout
+		internal let open operator private precedencegroup protocol public rethrows static struct subscript
+		typealias var break case catch continue default defer do else fallthrough for guard if in repeat return
+		throw switch where while as await false is nil self super throws true try`)
+	// 표준 타입/생성되는 헬퍼와 겹치는 struct명
+	swiftReservedTypes = newWordSet(`Any Array Bool Codable CodingKeys Data Date Decoder Dictionary Double Encoder
+		Error Int Int64 JSONValue Optional Protocol Self Set String Type URL`)
+)
+
+// Swift 프로퍼티 식별자 (camelCase, 예약어는 백틱)
+func swiftIdent(name string) string {
+	id := camelCase(name)
+	switch {
+	case id == "":
+		return "field"
+	case startsWithDigit(id):
+		return "_" + id
+	case swiftKeywords[id]:
+		return "`" + id + "`"
+	}
+	return id
+}
+
+// Swift struct명 (PascalCase, 표준 타입과 겹치면 _ 접미사)
+func swiftTypeName(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type_"
+	case startsWithDigit(id):
+		return "_" + id
+	case swiftReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+// Swift 타입 변환 (배열은 [T], 값이 없을 수 있으면 ?)
+func swiftType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	t := swiftPrimitive(base)
+	for i := 0; i < depth; i++ {
+		t = fmt.Sprintf("[%s]", t)
+	}
+	if isNullableField(field) || base == models.TypeObject && depth == 0 {
+		t += "?"
+	}
+	return t
+}
+
+// 기본 타입 → Swift 타입 (알 수 없는 값은 JSONValue)
+func swiftPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "String"
+	case models.TypeBool:
+		return "Bool"
+	case models.TypeInt:
+		return "Int"
+	case models.TypeLong:
+		return "Int64"
+	case models.TypeFloat:
+		return "Double"
+	case models.TypeObject:
+		return "JSONValue"
+	}
+	return swiftTypeName(t)
+}
+
+// Swift 코드 생성기 - Codable struct + CodingKeys, JSONDecoder 입출력
+func GenerateSwiftCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	sb.WriteString("import Foundation\n\n")
+
+	// struct (하위 타입 먼저)
+	types := outputTypes(field)
+	for _, t := range types {
+		writeSwiftStruct(t, &sb)
+	}
+
+	// 타입을 알 수 없는 값이 있을 때만 JSONValue 헬퍼 포함
+	if usesUnknownType(types) {
+		sb.WriteString(swiftJSONValue)
+	}
+
+	// JSON 입출력 (Foundation에는 XML Codable 지원이 없으므로 XML은 생략)
+	if HasKind(outputKinds, OutputJSON) {
+		for i, r := range outputRoots(field, rootName) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			root := swiftTypeName(r.Name)
+			sb.WriteString(fmt.Sprintf("enum %sIO {\n", root))
+			sb.WriteString("    // 파일에서 JSON 읽기\n")
+			sb.WriteString(fmt.Sprintf("    static func loadFromJsonFile(path: String) throws -> %s {\n", root))
+			sb.WriteString("        let data = try Data(contentsOf: URL(fileURLWithPath: path))\n")
+			sb.WriteString(fmt.Sprintf("        return try JSONDecoder().decode(%s.self, from: data)\n    }\n\n", root))
+			sb.WriteString("    // JSON 파일로 저장\n")
+			sb.WriteString(fmt.Sprintf("    static func saveToJsonFile(path: String, value: %s) throws {\n", root))
+			sb.WriteString("        let encoder = JSONEncoder()\n")
+			sb.WriteString("        encoder.outputFormatting = [.prettyPrinted]\n")
+			sb.WriteString("        try encoder.encode(value).write(to: URL(fileURLWithPath: path))\n    }\n")
+			sb.WriteString("}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func writeSwiftStruct(field models.Field, sb *strings.Builder) {
+	sb.WriteString(fmt.Sprintf("struct %s: Codable {\n", swiftTypeName(typeName(field))))
+	if len(field.Children) == 0 {
+		sb.WriteString("}\n\n")
+		return
+	}
+	idents := memberIdents(field.Children, swiftIdent)
+	for i, c := range field.Children {
+		sb.WriteString(fmt.Sprintf("    var %s: %s\n", idents[i], swiftType(c)))
+	}
+
+	// 프로퍼티명 ↔ 원본 키 매핑
+	sb.WriteString("\n    enum CodingKeys: String, CodingKey {\n")
+	for i, c := range field.Children {
+		if strings.Trim(idents[i], "`") == wireName(c) {
+			sb.WriteString(fmt.Sprintf("        case %s\n", idents[i]))
+		} else {
+			sb.WriteString(fmt.Sprintf("        case %s = \"%s\"\n", idents[i], escapeString(wireName(c))))
+		}
+	}
+	sb.WriteString("    }\n}\n\n")
+}
+
+const swiftJSONValue = `// 타입을 알 수 없는 JSON 값
+enum JSONValue: Codable {
+    case string(String)
+    case number(Double)
+    case bool(Bool)
+    case array([JSONValue])
+    case object([String: JSONValue])
+    case null
+
+    init(from decoder: Decoder) throws {
+        let container = try decoder.singleValueContainer()
+        if container.decodeNil() {
+            self = .null
+        } else if let value = try? container.decode(Bool.self) {
+            self = .bool(value)
+        } else if let value = try? container.decode(Double.self) {
+            self = .number(value)
+        } else if let value = try? container.decode(String.self) {
+            self = .string(value)
+        } else if let value = try? container.decode([JSONValue].self) {
+            self = .array(value)
+        } else {
+            self = .object(try container.decode([String: JSONValue].self))
+        }
+    }
+
+    func encode(to encoder: Encoder) throws {
+        var container = encoder.singleValueContainer()
+        switch self {
+        case .string(let value): try container.encode(value)
+        case .number(let value): try container.encode(value)
+        case .bool(let value): try container.encode(value)
+        case .array(let value): try container.encode(value)
+        case .object(let value): try container.encode(value)
+        case .null: try container.encodeNil()
+        }
+    }
+}
+
+`
@@ -0,0 +1,209 @@
+package generator
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/nosuk/CodeGenerator/models"
+)
+
+func init() {
+	Register(NewSingleFileGenerator("typescript", ".ts", GenerateTypeScriptCode))
+}
+
+// TypeScript 타입 변환 (배열은 T[])
+func tsType(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	return tsPrimitive(base) + strings.Repeat("[]", depth)
+}
+
+// 기본 타입 → TypeScript 타입 (인터페이스명은 식별자 규칙 적용)
+func tsPrimitive(t string) string {
+	switch t {
+	case models.TypeString:
+		return "string"
+	case models.TypeBool:
+		return "boolean"
+	case models.TypeInt, models.TypeLong, models.TypeFloat:
+		return "number"
+	case models.TypeObject:
+		return "unknown"
+	}
+	return tsIdent(t)
+}
+
+// TypeScript 인터페이스 식별자 (PascalCase, 예약어/숫자 시작 회피)
+func tsIdent(name string) string {
+	id := pascalCase(name)
+	switch {
+	case id == "":
+		return "Type"
+	case startsWithDigit(id):
+		return "_" + id
+	case tsReservedTypes[id]:
+		return id + "_"
+	}
+	return id
+}
+
+var tsReservedTypes = newWordSet(`any boolean never number object string symbol undefined unknown void
+	Array Boolean Date Error Function Map Number Object Promise Record Set String`)
+
+var tsPlainKey = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// 프로퍼티 키: JSON 키를 그대로 사용 (식별자가 아니면 따옴표)
+func tsPropertyKey(name string) string {
+	if tsPlainKey.MatchString(name) {
+		return name
+	}
+	return fmt.Sprintf("\"%s\"", escapeString(name))
+}
+
+// TypeScript 코드 생성기 - interface 선언 + 런타임 검증(parseX) 함수
+func GenerateTypeScriptCode(field models.Field, rootName string, outputKinds ...OutputKind) string {
+	var sb strings.Builder
+
+	// 인터페이스 (하위 타입 먼저)
+	types := outputTypes(field)
+	for _, t := range types {
+		writeTSInterface(t, &sb)
+	}
+
+	// 런타임 검증 공통 함수
+	sb.WriteString(tsRuntimeHelpers)
+
+	// 타입별 검증 함수
+	for _, t := range types {
+		writeTSChecker(t, &sb)
+	}
+
+	// JSON 함수 (XML은 브라우저/Node 공통 표준 파서가 없어 생략)
+	if HasKind(outputKinds, OutputJSON) {
+		for i, r := range outputRoots(field, rootName) {
+			if i > 0 {
+				sb.WriteString("\n")
+			}
+			root := tsIdent(r.Name)
+			sb.WriteString(fmt.Sprintf("// JSON 문자열 → %s (형식이 다르면 Error)\n", root))
+			sb.WriteString(fmt.Sprintf("export function parse%sJson(text: string): %s {\n", root, root))
+			sb.WriteString(fmt.Sprintf("  return parse%s(JSON.parse(text));\n}\n\n", root))
+			sb.WriteString(fmt.Sprintf("// %s → JSON 문자열\n", root))
+			sb.WriteString(fmt.Sprintf("export function stringify%s(value: %s): string {\n", root, root))
+			sb.WriteString("  return JSON.stringify(value, null, 2);\n}\n")
+		}
+	}
+
+	return sb.String()
+}
+
+func writeTSInterface(field models.Field, sb *strings.Builder) {
+	sb.WriteString(fmt.Sprintf("export interface %s {\n", tsIdent(typeName(field))))
+	for _, c := range field.Children {
+		optional := ""
+		if c.Optional {
+			optional = "?"
+		}
+		t := tsType(c)
+		if c.Nullable && t != "unknown" {
+			t += " | null"
+		}
+		sb.WriteString(fmt.Sprintf("  %s%s: %s;\n", tsPropertyKey(wireName(c)), optional, t))
+	}
+	sb.WriteString("}\n\n")
+}
+
+// 타입별 check 함수 + export parseX 함수
+func writeTSChecker(field models.Field, sb *strings.Builder) {
+	name := tsIdent(typeName(field))
+	sb.WriteString(fmt.Sprintf("function check%s(value: unknown, path: string): %s {\n", name, name))
+	sb.WriteString(fmt.Sprintf("  if (!isObject(value)) return fail(path, \"%s\");\n", name))
+	sb.WriteString("  return {\n")
+	for _, c := range field.Children {
+		key := escapeString(wireName(c))
+		sb.WriteString(fmt.Sprintf("    %s: %s(value[\"%s\"], path + \".%s\"),\n",
+			tsPropertyKey(wireName(c)), tsCheckExpr(c), key, key))
+	}
+	sb.WriteString("  };\n}\n\n")
+
+	sb.WriteString(fmt.Sprintf("export function parse%s(json: unknown): %s {\n", name, name))
+	sb.WriteString(fmt.Sprintf("  return check%s(json, \"$\");\n}\n\n", name))
+}
+
+// 필드 검증 함수 식 (배열/null/누락 조합)
+func tsCheckExpr(field models.Field) string {
+	depth, base := fieldArrayType(field)
+	expr := tsBaseCheck(base)
+	for i := 0; i < depth; i++ {
+		expr = fmt.Sprintf("arrayOf(%s)", expr)
+	}
+	if field.Nullable {
+		expr = fmt.Sprintf("nullable(%s)", expr)
+	}
+	if field.Optional {
+		expr = fmt.Sprintf("optional(%s)", expr)
+	}
+	return expr
+}
+
+func tsBaseCheck(t string) string {
+	switch t {
+	case models.TypeString:
+		return "checkString"
+	case models.TypeBool:
+		return "checkBoolean"
+	case models.TypeInt, models.TypeLong:
+		return "checkInteger"
+	case models.TypeFloat:
+		return "checkNumber"
+	case models.TypeObject:
+		return "checkUnknown"
+	}
+	return "check" + tsIdent(t)
+}
+
+const tsRuntimeHelpers = `// ---- 런타임 검증 ----
+type Check<T> = (value: unknown, path: string) => T;
+
+function fail(path: string, expected: string): never {
+  throw new Error(path + ": expected " + expected);
+}
+
+function isObject(value: unknown): value is Record<string, unknown> {
+  return typeof value === "object" && value !== null && !Array.isArray(value);
+}
+
+function checkString(value: unknown, path: string): string {
+  return typeof value === "string" ? value : fail(path, "string");
+}
+
+function checkNumber(value: unknown, path: string): number {
+  return typeof value === "number" ? value : fail(path, "number");
+}
+
+function checkInteger(value: unknown, path: string): number {
+  return typeof value === "number" && Number.isInteger(value) ? value : fail(path, "integer");
+}
+
+function checkBoolean(value: unknown, path: string): boolean {
+  return typeof value === "boolean" ? value : fail(path, "boolean");
+}
+
+function checkUnknown(value: unknown, _path: string): unknown {
+  return value;
+}
+
+function arrayOf<T>(check: Check<T>): Check<T[]> {
+  return (value, path) =>
+    Array.isArray(value) ? value.map((item, i) => check(item, path + "[" + i + "]")) : fail(path, "array");
+}
+
+function nullable<T>(check: Check<T>): Check<T | null> {
+  return (value, path) => (value === null ? null : check(value, path));
+}
+
+function optional<T>(check: Check<T>): Check<T | undefined> {
+  return (value, path) => (value === undefined ? undefined : check(value, path));
+}
+
+`
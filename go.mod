@@ -1,3 +1,9 @@
 module github.com/nosuk/CodeGenerator
 
 go 1.21.4
+
+require (
+	github.com/BurntSushi/toml v1.5.0
+	gopkg.in/ini.v1 v1.67.0
+	gopkg.in/yaml.v3 v3.0.1
+)
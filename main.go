@@ -1,12 +1,12 @@
 package main
 
 import (
-	"encoding/json"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/nosuk/CodeGenerator/generator"
@@ -14,8 +14,12 @@ import (
 )
 
 func main() {
-	inputPath := flag.String("input", "", "입력 파일 경로 (예: sample.json, sample.xml)")
-	lang := flag.String("lang", "", "타겟 언어 (csharp,go,python 여러개 쉼표 구분)")
+	inputPath := flag.String("input", "", "입력 파일 경로 (예: sample.json, sample.xml, sample.yaml / 여러 샘플은 쉼표 구분, 디렉토리, glob)")
+	rootName := flag.String("name", "", "루트 타입 이름 (기본값: 첫 입력 파일 또는 디렉토리 이름)")
+	lang := flag.String("lang", "", fmt.Sprintf("타겟 언어 (%s 중 여러개 쉼표 구분, 기본값: 전체)", strings.Join(generator.Names(), ",")))
+	sortFields := flag.Bool("sort", false, "필드를 원본 순서 대신 이름순으로 정렬")
+	format := flag.String("format", "", "입력 형식 (json, xml, yaml, toml, ini, jsonschema, openapi / 기본값: 확장자로 판단, JSON Schema와 OpenAPI는 명시 필요)")
+	namespace := flag.String("namespace", "", "생성 코드의 네임스페이스 (C++, PHP 등 지원 언어만, 예: acme::config)")
 	flag.Parse()
 
 	if *inputPath == "" {
@@ -23,45 +27,219 @@ func main() {
 		os.Exit(1)
 	}
 
-	base := filepath.Base(*inputPath)
-	name := strings.TrimSuffix(base, filepath.Ext(base))
-	rootClassName := models.ToExported(name)
-	dirName := name
+	if *format != "" && !inputFormats[*format] {
+		fmt.Printf("❗ 지원하지 않는 입력 형식: %s (지원 형식: %s)\n", *format, strings.Join(sortedKeys(inputFormats), ", "))
+		os.Exit(1)
+	}
+
+	langs, err := parseLangs(*lang)
+	if err != nil {
+		fmt.Println("❗", err)
+		os.Exit(1)
+	}
 
-	data, err := ioutil.ReadFile(*inputPath)
+	paths, err := expandInputPaths(*inputPath)
 	if err != nil {
-		fmt.Println("❗ 파일 읽기 오류:", err)
+		fmt.Println("❗ 입력 경로 오류:", err)
 		os.Exit(1)
 	}
 
-	// 1️⃣ 확장자 감지로 JSON/XML 파싱 분기
-	ext := strings.ToLower(filepath.Ext(*inputPath))
+	name := *rootName
+	if name == "" {
+		name = defaultRootName(*inputPath)
+	}
+	rootClassName := models.ToTypeName(name)
+	dirName := name
+
+	// 1️⃣ 샘플별로 파싱한 뒤 하나의 모델로 병합 (타입 확장, 누락 키는 optional)
 	var field models.Field
-	if ext == ".json" {
-		var raw interface{}
-		if err := json.Unmarshal(data, &raw); err != nil {
-			fmt.Println("❗ JSON 파싱 오류:", err)
+	for i, path := range paths {
+		sample, err := parseInputFile(path, *format, rootClassName)
+		if err != nil {
+			fmt.Printf("❗ %s 파싱 오류: %v\n", path, err)
 			os.Exit(1)
 		}
-		field = models.ParseJSONToFields(raw, rootClassName)
-	} else if ext == ".xml" {
-		field = models.ParseXMLToFields(data, rootClassName)
-	} else {
-		fmt.Println("지원하지 않는 입력 파일 형식입니다.")
-		os.Exit(1)
+		if i == 0 {
+			field = sample
+		} else {
+			field = models.MergeFields(field, sample)
+		}
+	}
+	if len(paths) > 1 {
+		fmt.Printf("📦 샘플 %d개 병합: %s\n", len(paths), strings.Join(paths, ", "))
+	}
+
+	if *sortFields {
+		field = models.SortFields(field)
+	}
+	// 구조가 같은 중첩 타입 통합, 이름 충돌 타입 구분
+	// OpenAPI 스키마는 구조가 같아도 스키마마다 타입 1개씩 유지
+	var keepTypes []models.Field
+	if *format == "openapi" {
+		keepTypes = field.Children
+		// 스키마 이름과 겹치면 루트(묶음) 타입명이 바뀜
+		rootClassName = field.Type
+	}
+	field = models.DedupTypes(field, keepTypes...)
+
+	// 2️⃣ 언어별 코드 생성 (기본값: 등록된 모든 언어)
+	opts := generator.Options{
+		RootName:    rootClassName,
+		BaseName:    name,
+		Namespace:   *namespace,
+		OutputKinds: []generator.OutputKind{generator.OutputJSON, generator.OutputXML}, // 필요시
+	}
+	for _, l := range langs {
+		g, _ := generator.Lookup(l)
+		generateCodeForLang(g, field, opts, dirName)
+	}
+}
+
+// -lang 값 → 언어 목록 (등록되지 않은 언어면 오류)
+func parseLangs(spec string) ([]string, error) {
+	if strings.TrimSpace(spec) == "" {
+		return generator.Names(), nil
+	}
+	var langs []string
+	for _, l := range strings.Split(spec, ",") {
+		l = strings.ToLower(strings.TrimSpace(l))
+		if l == "" {
+			continue
+		}
+		if _, ok := generator.Lookup(l); !ok {
+			return nil, fmt.Errorf("지원하지 않는 언어: %s (지원 언어: %s)", l, strings.Join(generator.Names(), ", "))
+		}
+		langs = append(langs, l)
+	}
+	return langs, nil
+}
+
+// 지원하는 입력 파일 확장자
+var inputExts = map[string]bool{
+	".json": true,
+	".xml":  true,
+	".yaml": true,
+	".yml":  true,
+	".toml": true,
+	".ini":  true,
+}
+
+// -format으로 지정 가능한 입력 형식
+var inputFormats = map[string]bool{
+	"json":       true,
+	"xml":        true,
+	"yaml":       true,
+	"toml":       true,
+	"ini":        true,
+	"jsonschema": true,
+	"openapi":    true,
+}
+
+// 입력 형식(-format, 없으면 확장자)에 따라 파싱 분기
+func parseInputFile(path, format, rootClassName string) (models.Field, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return models.Field{}, err
 	}
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	switch format {
+	case "json":
+		return models.ParseJSON(data, rootClassName)
+	case "xml":
+		return models.ParseXMLToFields(data, rootClassName)
+	case "yaml", "yml":
+		return models.ParseYAML(data, rootClassName)
+	case "toml":
+		return models.ParseTOML(data, rootClassName)
+	case "ini":
+		return models.ParseINI(data, rootClassName)
+	case "jsonschema":
+		return models.ParseJSONSchema(data, rootClassName)
+	case "openapi":
+		field, skipped, err := models.ParseOpenAPI(data, rootClassName)
+		if len(skipped) > 0 {
+			fmt.Printf("ℹ️ %s: 객체가 아닌 스키마는 타입을 만들지 않고 참조하는 필드에 펼침: %s\n", path, strings.Join(skipped, ", "))
+		}
+		return field, err
+	}
+	return models.Field{}, fmt.Errorf("지원하지 않는 입력 파일 형식입니다: %s", path)
+}
 
-	langs := []string{"csharp", "go", "python", "java"}
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
 
-	if *lang == "" {
-		for _, l := range langs {
-			generateCodeForLang(l, field, rootClassName, dirName, name)
+// -input 값 → 입력 파일 목록
+// 쉼표로 여러 경로 지정 가능, 디렉토리는 지원 확장자 파일 전체, glob 패턴은 매칭 파일
+func expandInputPaths(spec string) ([]string, error) {
+	var paths []string
+	for _, p := range strings.Split(spec, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if strings.ContainsAny(p, "*?[") {
+			matches, err := filepath.Glob(p)
+			if err != nil {
+				return nil, err
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("일치하는 파일이 없습니다: %s", p)
+			}
+			sort.Strings(matches)
+			paths = append(paths, matches...)
+			continue
+		}
+		info, err := os.Stat(p)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			paths = append(paths, p)
+			continue
+		}
+		entries, err := ioutil.ReadDir(p)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, e := range entries {
+			if !e.IsDir() && inputExts[strings.ToLower(filepath.Ext(e.Name()))] {
+				paths = append(paths, filepath.Join(p, e.Name()))
+				found = true
+			}
 		}
-	} else {
-		for _, l := range strings.Split(*lang, ",") {
-			generateCodeForLang(strings.ToLower(strings.TrimSpace(l)), field, rootClassName, dirName, name)
+		if !found {
+			return nil, fmt.Errorf("디렉토리에 입력 파일이 없습니다: %s", p)
 		}
 	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("입력 파일이 없습니다")
+	}
+	return paths, nil
+}
+
+// 루트 타입 이름 기본값: 첫 입력 경로의 파일명(확장자 제외) 또는 디렉토리명
+func defaultRootName(spec string) string {
+	first := strings.TrimSpace(strings.Split(spec, ",")[0])
+	if strings.ContainsAny(first, "*?[") {
+		if matches, _ := filepath.Glob(first); len(matches) > 0 {
+			sort.Strings(matches)
+			first = matches[0]
+		}
+	}
+	base := filepath.Base(filepath.Clean(first))
+	if info, err := os.Stat(first); err == nil && info.IsDir() {
+		return base
+	}
+	return strings.TrimSuffix(base, filepath.Ext(base))
 }
 
 // generator/아래 OutputKind와 일치해야 함!
@@ -73,40 +251,24 @@ const (
 )
 
 // 언어별 코드 생성/저장 함수
-func generateCodeForLang(lang string, field models.Field, rootClassName, dirName, baseName string) {
-	var code string
-	var ext string
-	kinds := []generator.OutputKind{generator.OutputJSON, generator.OutputXML} // 필요시
-
-	switch lang {
-	case "csharp":
-		code = generator.GenerateCSharpCode(field, rootClassName, kinds...)
-		ext = ".cs"
-	case "go":
-		code = generator.GenerateGoCode(field, rootClassName, kinds...)
-		ext = ".go"
-	case "python":
-		code = generator.GeneratePythonCode(field, rootClassName, kinds...)
-		ext = ".py"
-	case "java":
-		code = generator.GenerateJavaCode(field, rootClassName, kinds...)
-		ext = ".java"
-	default:
-		fmt.Printf("⚠️ 지원하지 않는 언어: %s\n", lang)
+func generateCodeForLang(g generator.Generator, field models.Field, opts generator.Options, dirName string) {
+	files, err := g.Generate(field, opts)
+	if err != nil {
+		fmt.Printf("❗ %s 코드 생성 오류: %v\n", g.Name(), err)
 		return
 	}
 
-	targetDir := filepath.Join(".", dirName, lang)
-	targetPath := filepath.Join(targetDir, baseName+ext)
-
-	if err := os.MkdirAll(targetDir, 0755); err != nil {
-		fmt.Println("❗ 디렉토리 생성 오류:", err)
-		return
-	}
-	if err := ioutil.WriteFile(targetPath, []byte(code), 0644); err != nil {
-		fmt.Println("❗ 파일 저장 오류:", err)
-		return
+	targetDir := filepath.Join(".", dirName, g.Name())
+	for _, f := range files {
+		targetPath := filepath.Join(targetDir, f.Path)
+		if err := os.MkdirAll(filepath.Dir(targetPath), 0755); err != nil {
+			fmt.Println("❗ 디렉토리 생성 오류:", err)
+			return
+		}
+		if err := ioutil.WriteFile(targetPath, []byte(f.Content), 0644); err != nil {
+			fmt.Println("❗ 파일 저장 오류:", err)
+			return
+		}
+		fmt.Printf("✅ %s 코드 생성 완료: %s\n", g.Name(), targetPath)
 	}
-
-	fmt.Printf("✅ %s 코드 생성 완료: %s\n", lang, targetPath)
 }
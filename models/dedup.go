@@ -0,0 +1,190 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// 중첩 타입 1개의 구조 정보
+type typeShape struct {
+	preferred string // 원래 타입명
+	parent    string // 처음 등장한 위치의 부모 타입명
+}
+
+// 중첩 타입 정리
+//   - 구조가 같은 타입(billingAddress/shippingAddress)은 처음 등장한 이름 하나로 통합
+//   - 이름은 같지만 구조가 다른 타입은 부모 타입명을 붙여 구분 (ProfileAddress/CompanyAddress)
+//   - 그래도 겹치면 숫자 접미사 (Address2)
+//   - keep에 있는 타입(OpenAPI 스키마 등)은 구조가 같은 다른 타입과 통합하지 않고 이름 유지
+//     (이름만 같고 구조가 다른 타입은 일반 타입처럼 이름 변경)
+func DedupTypes(root Field, keep ...Field) Field {
+	d := &typeShapes{
+		keep:     map[string]Field{},
+		keptSigs: map[string]string{},
+		shapes:   map[string]*typeShape{},
+	}
+	for _, f := range keep {
+		_, name := splitArrayPrefix(f.Type)
+		d.keep[name] = f
+	}
+	rootSig := d.signature(root, "", true)
+
+	// 이름별로 서로 다른 구조 수 집계
+	_, rootType := splitArrayPrefix(root.Type)
+	byName := map[string]int{}
+	for _, sig := range d.order {
+		byName[d.shapes[sig].preferred]++
+	}
+
+	names := map[string]string{rootSig: rootType}
+	used := map[string]bool{rootType: true}
+	for _, sig := range d.order {
+		if name := d.shapes[sig].preferred; d.isKept(name, sig) && sig != rootSig {
+			names[sig] = name
+			used[name] = true
+		}
+	}
+	for _, sig := range d.order {
+		if _, ok := names[sig]; ok {
+			continue
+		}
+		shape := d.shapes[sig]
+		name := shape.preferred
+		if _, kept := d.keep[name]; byName[name] > 1 || name == rootType || kept {
+			name = shape.parent + name
+		}
+		candidate := name
+		for i := 2; used[candidate]; i++ {
+			candidate = fmt.Sprintf("%s%d", name, i)
+		}
+		used[candidate] = true
+		names[sig] = candidate
+	}
+
+	root = d.renameTypes(root, names)
+
+	// Format/Enum은 시그니처에서 빠지므로 통합된 타입의 인스턴스마다 다를 수 있음 → 필드별로 병합
+	meta := map[string][]Field{}
+	collectFieldMeta(root, meta)
+	return applyFieldMeta(root, meta)
+}
+
+// 구조 시그니처 → 타입 정보 (처음 등장한 순서 유지)
+type typeShapes struct {
+	keep     map[string]Field  // 이름을 유지할 타입
+	keptSigs map[string]string // keep 타입 자체의 시그니처
+	shapes   map[string]*typeShape
+	order    []string
+}
+
+// 구조 시그니처 계산 (재귀), record면 처음 등장한 구조 기록
+// keep 타입과 이름·구조가 모두 같으면 이름도 시그니처에 포함해 다른 타입과 통합되지 않게 함
+func (d *typeShapes) signature(f Field, parent string, record bool) string {
+	_, typeName := splitArrayPrefix(f.Type)
+	parts := make([]string, 0, len(f.Children))
+	for _, c := range f.Children {
+		childType := c.Type
+		if c.IsComplex {
+			prefix, _ := splitArrayPrefix(c.Type)
+			childType = prefix + "{" + d.signature(c, typeName, record) + "}"
+		}
+		// Format/Enum은 JSON Schema 출력용 정보라 구조 비교에서 제외
+		parts = append(parts, fmt.Sprintf("%s:%s:%t:%t:%t:%d:%s:%s:%t",
+			c.Name, childType, c.IsArray, c.Optional, c.Nullable, c.XMLKind, c.WireName, c.XMLItemName, c.XMLUnwrapped))
+	}
+	sig := strings.Join(parts, ";")
+	if keptSig, ok := d.keptSig(typeName); ok && keptSig == sig {
+		sig = typeName + "=" + sig
+	}
+	if _, ok := d.shapes[sig]; record && !ok {
+		d.shapes[sig] = &typeShape{preferred: typeName, parent: parent}
+		d.order = append(d.order, sig)
+	}
+	return sig
+}
+
+// keep 타입 자체의 (이름 없는) 시그니처
+func (d *typeShapes) keptSig(name string) (string, bool) {
+	if sig, ok := d.keptSigs[name]; ok {
+		return sig, true
+	}
+	f, ok := d.keep[name]
+	if !ok {
+		return "", false
+	}
+	// 자기 참조 중에는 이름을 붙이지 않도록 시그니처로 나올 수 없는 값을 먼저 넣어 둠
+	d.keptSigs[name] = "\x00"
+	sig := d.signature(f, "", false)
+	d.keptSigs[name] = sig
+	return sig, true
+}
+
+// 이름을 유지할 keep 타입의 시그니처인지
+func (d *typeShapes) isKept(name, sig string) bool {
+	_, ok := d.keep[name]
+	return ok && strings.HasPrefix(sig, name+"=")
+}
+
+// 시그니처별로 정해진 타입명 적용 (재귀)
+func (d *typeShapes) renameTypes(f Field, names map[string]string) Field {
+	if !f.IsComplex {
+		return f
+	}
+	sig := d.signature(f, "", false)
+	prefix, _ := splitArrayPrefix(f.Type)
+	f.Type = prefix + names[sig]
+
+	children := make([]Field, len(f.Children))
+	for i, c := range f.Children {
+		children[i] = d.renameTypes(c, names)
+	}
+	f.Children = children
+	return f
+}
+
+// 타입명별 자식 필드의 Format/Enum 병합 (자식은 Name/WireName으로 대응, 대응하는 필드가 없으면 건너뜀)
+func collectFieldMeta(f Field, meta map[string][]Field) {
+	if !f.IsComplex {
+		return
+	}
+	_, name := splitArrayPrefix(f.Type)
+	if existing, ok := meta[name]; ok {
+		for _, c := range f.Children {
+			if i := findMetaField(existing, c); i >= 0 {
+				existing[i].Format = mergeFormat(existing[i].Format, c.Format)
+				existing[i].Enum = mergeEnum(existing[i].Enum, c.Enum)
+			}
+		}
+	} else {
+		meta[name] = append([]Field{}, f.Children...)
+	}
+	for _, c := range f.Children {
+		collectFieldMeta(c, meta)
+	}
+}
+
+func applyFieldMeta(f Field, meta map[string][]Field) Field {
+	if !f.IsComplex {
+		return f
+	}
+	_, name := splitArrayPrefix(f.Type)
+	merged := meta[name]
+	children := make([]Field, len(f.Children))
+	for i, c := range f.Children {
+		if j := findMetaField(merged, c); j >= 0 {
+			c.Format, c.Enum = merged[j].Format, merged[j].Enum
+		}
+		children[i] = applyFieldMeta(c, meta)
+	}
+	f.Children = children
+	return f
+}
+
+func findMetaField(fields []Field, f Field) int {
+	for i, c := range fields {
+		if c.Name == f.Name && c.WireName == f.WireName {
+			return i
+		}
+	}
+	return -1
+}
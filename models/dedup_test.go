@@ -0,0 +1,104 @@
+package models
+
+import "testing"
+
+// components/schemas의 User와 이름만 같고 구조가 다른 인라인 user 객체
+const keptNameOpenAPI = `
+openapi: 3.0.0
+components:
+  schemas:
+    Order:
+      type: object
+      properties:
+        user:
+          type: object
+          properties:
+            a: {type: string}
+            b: {type: integer}
+        owner: {$ref: '#/components/schemas/User'}
+        buyer:
+          type: object
+          properties:
+            x: {type: string}
+    User:
+      type: object
+      properties:
+        x: {type: string}
+    Account:
+      type: object
+      properties:
+        x: {type: string}
+`
+
+func TestDedupTypes(t *testing.T) {
+	type want struct {
+		path string
+		typ  string
+	}
+	tests := []struct {
+		name    string
+		json    string
+		openapi string
+		want    []want
+	}{
+		{
+			name: "구조가 같은 타입은 처음 이름으로 통합",
+			json: `{"billing":{"city":"a"},"shipping":{"city":"b"}}`,
+			want: []want{{"Billing", "Billing"}, {"Shipping", "Billing"}},
+		},
+		{
+			name: "이름이 같고 구조가 다르면 부모 이름으로 구분",
+			json: `{"profile":{"address":{"city":"a"}},"company":{"address":{"zip":1}}}`,
+			want: []want{{"Profile/Address", "ProfileAddress"}, {"Company/Address", "CompanyAddress"}},
+		},
+		{
+			name: "루트와 이름이 같은 중첩 타입",
+			json: `{"root":{"a":1}}`,
+			want: []want{{"", "Root"}, {"Root", "RootRoot"}},
+		},
+		{
+			name:    "OpenAPI 스키마 이름은 스키마 자신의 구조에만",
+			openapi: keptNameOpenAPI,
+			want: []want{
+				{"User", "User"},
+				{"Order/Owner", "User"},
+				{"Order/User", "OrderUser"},
+				{"Account", "Account"},
+				// 구조가 같은 스키마(User/Account)가 있어도 인라인 객체는 스키마와 통합하지 않음
+				{"Order/Buyer", "Buyer"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var root Field
+			var keep []Field
+			if tt.openapi != "" {
+				var err error
+				root, _, err = ParseOpenAPI([]byte(tt.openapi), "api")
+				if err != nil {
+					t.Fatalf("ParseOpenAPI: %v", err)
+				}
+				keep = root.Children
+			} else {
+				root = mustParseJSON(t, tt.json, "root")
+			}
+			root = DedupTypes(root, keep...)
+			for _, w := range tt.want {
+				if got := fieldAt(t, root, w.path).Type; got != w.typ {
+					t.Errorf("%s: got %s, want %s", w.path, got, w.typ)
+				}
+			}
+		})
+	}
+}
+
+func TestDedupTypesMergesFieldMeta(t *testing.T) {
+	root := DedupTypes(mustParseJSON(t, `{"a":{"at":"2024-01-02","id":"x"},"b":{"at":"2024-03-04","id":"y"},"c":{"at":"z","id":"w"}}`, "root"))
+	// 통합된 타입의 인스턴스 중 하나라도 형식이 다르면 형식 없음
+	for _, path := range []string{"A/At", "B/At", "C/At"} {
+		if got := fieldAt(t, root, path).Format; got != "" {
+			t.Errorf("%s: Format = %q, want \"\"", path, got)
+		}
+	}
+}
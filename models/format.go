@@ -0,0 +1,84 @@
+package models
+
+import (
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// 문자열 값 형식 (JSON Schema format 키워드 값)
+const (
+	FormatDateTime = "date-time"
+	FormatDate     = "date"
+	FormatEmail    = "email"
+	FormatIPv4     = "ipv4"
+	FormatIPv6     = "ipv6"
+	FormatURI      = "uri"
+	FormatUUID     = "uuid"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// 문자열 샘플 값의 형식 추정 (해당 없으면 "")
+func DetectFormat(s string) string {
+	switch {
+	case s == "":
+		return ""
+	case isDateTime(s):
+		return FormatDateTime
+	case isDate(s):
+		return FormatDate
+	case uuidPattern.MatchString(s):
+		return FormatUUID
+	case isIP(s, true):
+		return FormatIPv4
+	case isIP(s, false):
+		return FormatIPv6
+	case isEmail(s):
+		return FormatEmail
+	case isURI(s):
+		return FormatURI
+	}
+	return ""
+}
+
+func isDateTime(s string) bool {
+	_, err := time.Parse(time.RFC3339Nano, s)
+	return err == nil
+}
+
+func isDate(s string) bool {
+	_, err := time.Parse("2006-01-02", s)
+	return err == nil
+}
+
+func isIP(s string, v4 bool) bool {
+	ip := net.ParseIP(s)
+	if ip == nil {
+		return false
+	}
+	return (ip.To4() != nil && strings.Contains(s, ".")) == v4
+}
+
+// 표시 이름 없는 주소만 ("a@b.com", "Name <a@b.com>"은 제외)
+func isEmail(s string) bool {
+	addr, err := mail.ParseAddress(s)
+	return err == nil && addr.Address == s && strings.Contains(s[strings.LastIndex(s, "@"):], ".")
+}
+
+// 스킴과 호스트가 있는 절대 URI
+func isURI(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && u.Scheme != "" && u.Host != "" && !strings.ContainsAny(s, " \t\n")
+}
+
+// 두 샘플의 형식 병합 (다르면 형식 없음)
+func mergeFormat(a, b string) string {
+	if a == b {
+		return a
+	}
+	return ""
+}
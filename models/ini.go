@@ -0,0 +1,84 @@
+package models
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"gopkg.in/ini.v1"
+)
+
+// INI 샘플 → Field 트리
+// 섹션 밖 키는 루트 필드, 섹션은 중첩 타입 ([server.tls]는 server 아래 tls)
+// INI 값은 모두 문자열이므로 불리언/정수/실수로 읽히는 값은 해당 타입으로 추정
+func ParseINI(data []byte, name string) (Field, error) {
+	file, err := ini.LoadSources(ini.LoadOptions{SpaceBeforeInlineComment: true}, data)
+	if err != nil {
+		return Field{}, err
+	}
+
+	root := OrderedObject{}
+	for _, section := range file.Sections() {
+		obj := iniSectionValue(section)
+		if section.Name() == ini.DefaultSection {
+			root = append(root, obj...)
+			continue
+		}
+		if len(obj) == 0 && len(section.ChildSections()) > 0 {
+			// 하위 섹션만 있는 상위 섹션 ([a.b]만 있을 때의 a)
+			continue
+		}
+		root = setINIPath(root, strings.Split(section.Name(), "."), obj)
+	}
+	return ParseJSONToFields(root, name), nil
+}
+
+// 섹션의 키 → OrderedObject (파일 내 순서 유지)
+func iniSectionValue(section *ini.Section) OrderedObject {
+	obj := OrderedObject{}
+	for _, key := range section.Keys() {
+		obj = obj.set(key.Name(), iniScalarValue(key.Value()))
+	}
+	return obj
+}
+
+// 점으로 구분된 섹션 경로에 값 설정 (중간 섹션이 없으면 생성, 있으면 그 아래에 추가)
+func setINIPath(obj OrderedObject, path []string, value OrderedObject) OrderedObject {
+	if len(path) == 1 {
+		if existing, ok := obj.Get(path[0]); ok {
+			if m, ok := existing.(OrderedObject); ok {
+				return obj.set(path[0], append(value, m...))
+			}
+		}
+		return obj.set(path[0], value)
+	}
+	child, _ := obj.Get(path[0])
+	m, _ := child.(OrderedObject)
+	return obj.set(path[0], setINIPath(m, path[1:], value))
+}
+
+// 문자열 값 → 불리언/숫자(json.Number)/문자열
+func iniScalarValue(s string) interface{} {
+	switch strings.ToLower(s) {
+	case "true", "false":
+		return strings.ToLower(s) == "true"
+	}
+	if hasLeadingZero(s) {
+		// 우편번호/코드("04524")는 숫자로 바꾸면 앞자리 0이 사라지므로 문자열로
+		return s
+	}
+	if _, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return json.Number(s)
+	}
+	if _, err := strconv.ParseFloat(s, 64); err == nil && strings.ContainsAny(s, ".eE") && !strings.ContainsAny(s, "nN") {
+		// "Inf", "NaN" 등 단어는 문자열로
+		return json.Number(s)
+	}
+	return s
+}
+
+// 정수부가 0으로 시작하는 두 자리 이상 숫자인지 ("0", "0.5"는 제외)
+func hasLeadingZero(s string) bool {
+	s = strings.TrimLeft(s, "+-")
+	return len(s) > 1 && s[0] == '0' && s[1] >= '0' && s[1] <= '9'
+}
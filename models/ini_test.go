@@ -0,0 +1,71 @@
+package models
+
+import "testing"
+
+func TestParseINIScalars(t *testing.T) {
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"true", TypeBool},
+		{"False", TypeBool},
+		{"0", TypeInt},
+		{"42", TypeInt},
+		{"-7", TypeInt},
+		{"3000000000", TypeLong},
+		{"0.5", TypeFloat},
+		{"1e3", TypeFloat},
+		{"-1.25", TypeFloat},
+		// 앞자리 0은 코드/우편번호로 보고 문자열 유지
+		{"04524", TypeString},
+		{"-012", TypeString},
+		{"00.5", TypeString},
+		{"Inf", TypeString},
+		{"NaN", TypeString},
+		{"0x1F", TypeString},
+		{"hello", TypeString},
+		{"", TypeString},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			root, err := ParseINI([]byte("v = "+tt.value+"\n"), "config")
+			if err != nil {
+				t.Fatalf("ParseINI: %v", err)
+			}
+			if got := fieldAt(t, root, "V").Type; got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseINISections(t *testing.T) {
+	root, err := ParseINI([]byte(`name = app
+[server]
+port = 8080
+[server.tls]
+enabled = true
+[db.primary]
+host = localhost ; 주석
+`), "config")
+	if err != nil {
+		t.Fatalf("ParseINI: %v", err)
+	}
+	tests := []struct {
+		path string
+		want fieldShape
+	}{
+		{"Name", fieldShape{Type: TypeString, WireName: "name"}},
+		{"Server/Port", fieldShape{Type: TypeInt, WireName: "port"}},
+		{"Server/Tls/Enabled", fieldShape{Type: TypeBool, WireName: "enabled"}},
+		// 하위 섹션만 있는 상위 섹션도 중첩 타입
+		{"Db/Primary/Host", fieldShape{Type: TypeString, WireName: "host"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
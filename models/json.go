@@ -0,0 +1,148 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// 키 순서를 보존하는 JSON 객체
+type OrderedObject []OrderedEntry
+
+type OrderedEntry struct {
+	Key   string
+	Value interface{}
+}
+
+// JSON 바이트 → Field 트리
+// 토큰 단위로 읽어 원본 키 순서를 유지하고,
+// 숫자를 json.Number로 읽어 정수/실수, 32/64비트를 구분
+func ParseJSON(data []byte, name string) (Field, error) {
+	raw, err := DecodeOrderedJSON(data)
+	if err != nil {
+		return Field{}, err
+	}
+	return ParseJSONToFields(raw, name), nil
+}
+
+// JSON 문서 → OrderedObject/[]interface{}/json.Number/string/bool/nil 값
+func DecodeOrderedJSON(data []byte) (interface{}, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	v, err := decodeOrderedValue(dec)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return nil, errors.New("JSON 문서 뒤에 불필요한 데이터가 있습니다")
+	}
+	return v, nil
+}
+
+func decodeOrderedValue(dec *json.Decoder) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		obj := OrderedObject{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			key := keyTok.(string)
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj = obj.set(key, value)
+		}
+		if _, err := dec.Token(); err != nil { // '}'
+			return nil, err
+		}
+		return obj, nil
+	case '[':
+		arr := []interface{}{}
+		for dec.More() {
+			value, err := decodeOrderedValue(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		if _, err := dec.Token(); err != nil { // ']'
+			return nil, err
+		}
+		return arr, nil
+	}
+	return nil, errors.New("잘못된 JSON 구분자")
+}
+
+// 키로 값 조회
+func (o OrderedObject) Get(key string) (interface{}, bool) {
+	for _, entry := range o {
+		if entry.Key == key {
+			return entry.Value, true
+		}
+	}
+	return nil, false
+}
+
+// 중복 키는 encoding/json과 같이 마지막 값 사용 (위치는 처음 등장한 곳)
+func (o OrderedObject) set(key string, value interface{}) OrderedObject {
+	for i := range o {
+		if o[i].Key == key {
+			o[i].Value = value
+			return o
+		}
+	}
+	return append(o, OrderedEntry{Key: key, Value: value})
+}
+
+// 키 순서를 유지한 채 JSON 객체로 직렬화
+func (o OrderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, entry := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, err := json.Marshal(entry.Key)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(entry.Value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// 실수 → json.Number (1.0처럼 정수로 보이는 실수도 실수 타입 유지)
+// JSON 숫자로 쓸 수 없는 Inf/NaN은 float64 그대로
+func floatNumber(f float64) interface{} {
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return f
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".eE") {
+		s += ".0"
+	}
+	return json.Number(s)
+}
@@ -0,0 +1,398 @@
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+)
+
+// JSON Schema 문서 → Field 트리
+// properties/required/items, $ref($defs 등 문서 내부 참조), enum, oneOf/anyOf, format, nullable(OpenAPI 3.0) 지원
+func ParseJSONSchema(data []byte, name string) (Field, error) {
+	raw, err := DecodeOrderedJSON(data)
+	if err != nil {
+		return Field{}, err
+	}
+	// 루트 자신을 가리키는 "#"도 순환 참조로 처리
+	p := &schemaParser{doc: raw, resolving: []string{"#"}}
+	field := p.field(raw, name, "")
+	if p.err != nil {
+		return Field{}, p.err
+	}
+	return field, nil
+}
+
+// $ref 해석 상태 (문서 루트, 순환 참조 감지용 경로 스택)
+// Field는 트리 구조라 재귀 타입을 표현할 수 없으므로 순환 참조는 알 수 없는 타입(object)이 됨
+type schemaParser struct {
+	doc       interface{}
+	resolving []string
+	refs      map[string]Field // nil이 아니면 $ref 해석 결과 재사용 (같은 참조는 어디서나 같은 구조)
+	err       error
+}
+
+// 스키마 노드 → Field
+// typeName이 있으면 복합 타입명으로 사용 ($ref 대상 이름), 없으면 속성 이름에서 생성
+func (p *schemaParser) field(node interface{}, name, typeName string) Field {
+	base := Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+	schema, ok := node.(OrderedObject)
+	if !ok || p.err != nil {
+		// true/false 스키마 등 → 알 수 없는 타입
+		return base
+	}
+
+	if ref, ok := schema.Get("$ref"); ok {
+		return withNullable(p.ref(fmt.Sprint(ref), name), schema)
+	}
+
+	if parts, ok := schemaValue(schema, "allOf").([]interface{}); ok && len(parts) > 0 {
+		return withNullable(p.allOf(schema, parts, name, typeName), schema)
+	}
+
+	// oneOf/anyOf: 모든 후보 구조를 병합 (한쪽에만 있는 키는 Optional)
+	for _, key := range []string{"oneOf", "anyOf"} {
+		if alts, ok := schema.Get(key); ok {
+			if list, ok := alts.([]interface{}); ok && len(list) > 0 {
+				f := p.field(list[0], name, typeName)
+				complexTypes := map[string]bool{}
+				for i, alt := range list {
+					af := f
+					if i > 0 {
+						af = p.field(alt, name, typeName)
+						f = MergeFields(f, af)
+					}
+					if af.IsComplex {
+						complexTypes[af.Type] = true
+					}
+				}
+				if len(complexTypes) > 1 {
+					// 서로 다른 $ref를 합친 타입은 속성 이름으로 명명
+					if typeName == "" {
+						typeName = ToTypeName(name)
+					}
+					prefix, _ := splitArrayPrefix(f.Type)
+					f.Type = prefix + typeName
+				}
+				return withNullable(f, schema)
+			}
+		}
+	}
+
+	// type: "string" 또는 ["string", "null"]
+	var types []string
+	switch t := schemaValue(schema, "type").(type) {
+	case string:
+		types = []string{t}
+	case []interface{}:
+		for _, v := range t {
+			types = append(types, fmt.Sprint(v))
+		}
+	}
+	if len(types) == 0 {
+		types = inferSchemaTypes(schema)
+	}
+
+	nullable := false
+	var result *Field
+	for _, t := range types {
+		if t == "null" {
+			nullable = true
+			continue
+		}
+		f := p.typed(schema, t, name, typeName)
+		if result == nil {
+			result = &f
+		} else {
+			merged := MergeFields(*result, f)
+			result = &merged
+		}
+	}
+	if result == nil {
+		base.Nullable = nullable
+		return base
+	}
+	result.Nullable = result.Nullable || nullable
+	return withNullable(*result, schema)
+}
+
+// allOf: 모든 하위 스키마를 동시에 만족하므로 객체 속성을 하나로 합침 (필수 여부는 각 스키마 기준)
+// allOf와 같은 위치의 properties 등도 하위 스키마 하나로 취급
+func (p *schemaParser) allOf(schema OrderedObject, parts []interface{}, name, typeName string) Field {
+	rest := OrderedObject{}
+	for _, entry := range schema {
+		if entry.Key != "allOf" {
+			rest = append(rest, entry)
+		}
+	}
+	if len(inferSchemaTypes(rest)) > 0 || schemaValue(rest, "type") != nil {
+		parts = append(parts, rest)
+	}
+
+	var known, objects []Field
+	for _, part := range parts {
+		f := p.field(part, name, typeName)
+		switch {
+		case f.IsComplex && !f.IsArray:
+			objects = append(objects, f)
+		case !isUnknownField(f):
+			known = append(known, f)
+		}
+	}
+	unknown := Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+	switch {
+	case len(objects) == 0 && len(known) == 0:
+		return unknown
+	case len(objects) == 0:
+		// 설명만 덧붙인 $ref 등 기본 타입/배열 스키마
+		f := known[0]
+		for _, k := range known[1:] {
+			f = MergeFields(f, k)
+		}
+		return f
+	case len(known) > 0:
+		// 객체와 다른 타입을 동시에 만족하는 값은 표현할 수 없음
+		return unknown
+	case len(objects) == 1 && typeName == "":
+		// { allOf: [$ref] } 형태는 참조 타입 그대로
+		return objects[0]
+	}
+
+	if typeName == "" {
+		typeName = ToTypeName(name)
+	}
+	f := Field{Name: ToExported(name), WireName: name, Type: typeName, Children: []Field{}, IsComplex: true}
+	for _, obj := range objects {
+		f.Nullable = f.Nullable || obj.Nullable
+		for _, c := range obj.Children {
+			existing := -1
+			for i, fc := range f.Children {
+				if fc.Name == c.Name {
+					existing = i
+					break
+				}
+			}
+			if existing < 0 {
+				f.Children = append(f.Children, c)
+				continue
+			}
+			// 여러 스키마에 있는 속성은 하나라도 필수면 필수
+			merged := MergeFields(f.Children[existing], c)
+			merged.Optional = f.Children[existing].Optional && c.Optional
+			f.Children[existing] = merged
+		}
+	}
+	return f
+}
+
+// 단일 type 값에 따른 Field
+func (p *schemaParser) typed(schema OrderedObject, t, name, typeName string) Field {
+	f := Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+	switch t {
+	case "string":
+		f.Type = TypeString
+		if format, ok := schemaValue(schema, "format").(string); ok {
+			f.Format = format
+		}
+	case "integer":
+		f.Type = schemaIntegerType(schema)
+	case "number":
+		f.Type = TypeFloat
+	case "boolean":
+		f.Type = TypeBool
+	case "array":
+		return p.array(schema, name, typeName)
+	case "object":
+		props, ok := schemaValue(schema, "properties").(OrderedObject)
+		if !ok {
+			// 속성 정의가 없는 객체(맵 등)는 알 수 없는 타입
+			break
+		}
+		required := map[string]bool{}
+		if list, ok := schemaValue(schema, "required").([]interface{}); ok {
+			for _, r := range list {
+				required[fmt.Sprint(r)] = true
+			}
+		}
+		if typeName == "" {
+			typeName = ToTypeName(name)
+		}
+		children := []Field{}
+		for _, prop := range props {
+			child := p.field(prop.Value, prop.Key, "")
+			child.Optional = child.Optional || !required[prop.Key]
+			children = append(children, child)
+		}
+		f.Type = typeName
+		f.Children = children
+		f.IsComplex = true
+	}
+	values, _ := schemaValue(schema, "enum").([]interface{})
+	if v, ok := schema.Get("const"); ok {
+		values = []interface{}{v}
+	}
+	if !f.IsComplex {
+		// JSON 표현 그대로 보관 (null도 허용 값으로 유지)
+		for _, v := range values {
+			data, err := json.Marshal(v)
+			if err != nil {
+				p.err = err
+				return f
+			}
+			f.Enum = append(f.Enum, string(data))
+			f.Nullable = f.Nullable || v == nil
+		}
+	}
+	return f
+}
+
+// 배열 스키마 (원소가 배열이면 "[]" 접두사로 중첩 표현, ParseJSONToFields와 같은 규칙)
+func (p *schemaParser) array(schema OrderedObject, name, typeName string) Field {
+	var item Field
+	switch items := schemaValue(schema, "items").(type) {
+	case OrderedObject:
+		item = p.field(items, name, typeName)
+	case []interface{}:
+		// 이전 Draft의 튜플 형식은 모든 원소를 병합
+		item = Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+		for i, it := range items {
+			if i == 0 {
+				item = p.field(it, name, typeName)
+			} else {
+				item = MergeFields(item, p.field(it, name, typeName))
+			}
+		}
+	default:
+		item = Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+	}
+
+	f := Field{
+		Name:      ToExported(name),
+		WireName:  name,
+		Type:      item.Type,
+		Children:  item.Children,
+		IsArray:   true,
+		IsComplex: item.IsComplex,
+		Format:    item.Format,
+		Enum:      item.Enum,
+	}
+	if item.IsArray {
+		f.Type = "[]" + item.Type
+	}
+	return f
+}
+
+// 문서 내부 참조 ("#/$defs/Address") 해석, 참조 대상 이름을 타입명으로 사용
+func (p *schemaParser) ref(ref, name string) Field {
+	unknown := Field{Name: ToExported(name), WireName: name, Type: TypeObject}
+	if !strings.HasPrefix(ref, "#") {
+		p.err = fmt.Errorf("외부 $ref는 지원하지 않습니다: %s", ref)
+		return unknown
+	}
+	for _, r := range p.resolving {
+		if r == ref {
+			// 순환 참조는 알 수 없는 타입으로 끊음
+			return unknown
+		}
+	}
+
+	target, ok := resolvePointer(p.doc, strings.TrimPrefix(ref, "#"))
+	if !ok {
+		p.err = fmt.Errorf("$ref 대상을 찾을 수 없습니다: %s", ref)
+		return unknown
+	}
+	if cached, ok := p.refs[ref]; ok {
+		cached.Name, cached.WireName = ToExported(name), name
+		return cached
+	}
+	p.resolving = append(p.resolving, ref)
+	defer func() { p.resolving = p.resolving[:len(p.resolving)-1] }()
+
+	segments := strings.Split(ref, "/")
+	f := p.field(target, name, ToTypeName(unescapePointer(segments[len(segments)-1])))
+	if p.refs != nil && p.err == nil {
+		p.refs[ref] = f
+	}
+	return f
+}
+
+// JSON Pointer ("/$defs/Address") → 노드
+func resolvePointer(doc interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return doc, true
+	}
+	node := doc
+	for _, seg := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		obj, ok := node.(OrderedObject)
+		if !ok {
+			return nil, false
+		}
+		if node, ok = obj.Get(unescapePointer(seg)); !ok {
+			return nil, false
+		}
+	}
+	return node, true
+}
+
+func unescapePointer(seg string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(seg)
+}
+
+// type이 없을 때 다른 키워드로 타입 추정
+func inferSchemaTypes(schema OrderedObject) []string {
+	switch {
+	case schemaValue(schema, "properties") != nil:
+		return []string{"object"}
+	case schemaValue(schema, "items") != nil:
+		return []string{"array"}
+	}
+	values, _ := schemaValue(schema, "enum").([]interface{})
+	if v, ok := schema.Get("const"); ok {
+		values = []interface{}{v}
+	}
+	if len(values) > 0 {
+		switch values[0].(type) {
+		case string:
+			return []string{"string"}
+		case json.Number:
+			if _, err := values[0].(json.Number).Int64(); err == nil {
+				return []string{"integer"}
+			}
+			return []string{"number"}
+		case bool:
+			return []string{"boolean"}
+		case OrderedObject:
+			// 객체 값 enum은 알 수 없는 타입 + enum으로 유지
+			return []string{"object"}
+		}
+	}
+	return nil
+}
+
+// integer의 format(int64)이나 범위가 32비트를 넘으면 long
+func schemaIntegerType(schema OrderedObject) string {
+	if format, _ := schemaValue(schema, "format").(string); format == "int64" {
+		return TypeLong
+	}
+	for _, key := range []string{"minimum", "maximum"} {
+		if n, ok := schemaValue(schema, key).(json.Number); ok {
+			if v, err := n.Float64(); err == nil && (v < math.MinInt32 || v > math.MaxInt32) {
+				return TypeLong
+			}
+		}
+	}
+	return TypeInt
+}
+
+// OpenAPI 3.0의 nullable: true
+func withNullable(f Field, schema OrderedObject) Field {
+	if v, ok := schemaValue(schema, "nullable").(bool); ok && v {
+		f.Nullable = true
+	}
+	return f
+}
+
+func schemaValue(schema OrderedObject, key string) interface{} {
+	v, _ := schema.Get(key)
+	return v
+}
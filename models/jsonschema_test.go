@@ -0,0 +1,75 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+const orderSchema = `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["id", "items"],
+  "properties": {
+    "id": {"type": "integer"},
+    "status": {"enum": ["open", "closed", null]},
+    "items": {"type": "array", "items": {"$ref": "#/$defs/Item"}},
+    "billing": {"$ref": "#/$defs/Address"},
+    "shipping": {
+      "allOf": [
+        {"$ref": "#/$defs/Address"},
+        {"type": "object", "required": ["note"], "properties": {"note": {"type": "string"}}}
+      ]
+    },
+    "self": {"$ref": "#"}
+  },
+  "$defs": {
+    "Item": {
+      "type": "object",
+      "required": ["sku"],
+      "properties": {
+        "sku": {"type": "string"},
+        "qty": {"type": "integer", "format": "int64"}
+      }
+    },
+    "Address": {
+      "type": "object",
+      "required": ["city"],
+      "properties": {"city": {"type": "string"}, "zip": {"type": ["string", "null"]}}
+    }
+  }
+}`
+
+func TestParseJSONSchema(t *testing.T) {
+	root, err := ParseJSONSchema([]byte(orderSchema), "order")
+	if err != nil {
+		t.Fatalf("ParseJSONSchema: %v", err)
+	}
+	tests := []struct {
+		path string
+		want fieldShape
+	}{
+		{"", fieldShape{Type: "Order", WireName: "order", IsComplex: true}},
+		{"Id", fieldShape{Type: TypeInt, WireName: "id"}},
+		{"Status", fieldShape{Type: TypeString, WireName: "status", Optional: true, Nullable: true}},
+		{"Items", fieldShape{Type: "Item", WireName: "items", IsArray: true, IsComplex: true}},
+		{"Items/Qty", fieldShape{Type: TypeLong, WireName: "qty", Optional: true}},
+		{"Billing", fieldShape{Type: "Address", WireName: "billing", IsComplex: true, Optional: true}},
+		{"Billing/Zip", fieldShape{Type: TypeString, WireName: "zip", Optional: true, Nullable: true}},
+		// allOf: $ref 속성과 인라인 속성을 합침, 어느 하위 스키마에서든 필수면 필수
+		{"Shipping/City", fieldShape{Type: TypeString, WireName: "city"}},
+		{"Shipping/Note", fieldShape{Type: TypeString, WireName: "note"}},
+		// 순환 참조는 알 수 없는 타입
+		{"Self", fieldShape{Type: TypeObject, WireName: "self", Optional: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if got, want := fieldAt(t, root, "Status").Enum, []string{`"open"`, `"closed"`, `null`}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Status.Enum = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,176 @@
+package models
+
+import "strings"
+
+// 두 Field 병합 (배열 원소, 여러 샘플의 구조를 하나로 합치기)
+//   - 복합 타입은 키를 합집합으로 병합, 한쪽에만 있는 키는 Optional
+//   - null이 관찰되면 Nullable
+//   - 기본 타입이 다르면 넓은 타입으로 확장 (int → long → float, 그 외 충돌은 object)
+//   - 타입을 알 수 없는 값(빈 배열 원소 등)은 상대 타입을 따름 (배열끼리는 원소 구조)
+//   - 문자열 형식(Format)은 양쪽이 같을 때만 유지, enum은 양쪽 모두 있을 때 합집합
+func MergeFields(a, b Field) Field {
+	if isUnknownField(a) && !isUnknownField(b) {
+		b.Name = a.Name
+		b.Optional = a.Optional || b.Optional
+		b.Nullable = a.Nullable || b.Nullable
+		return b
+	}
+	if isUnknownField(b) {
+		a.Optional = a.Optional || b.Optional
+		a.Nullable = a.Nullable || b.Nullable
+		return a
+	}
+
+	if a.IsArray && b.IsArray {
+		// 빈 배열처럼 원소 타입을 모르는 배열은 상대 배열의 원소 구조를 따름
+		if isUnknownElement(a, b) {
+			b.Name = a.Name
+			b.Optional = a.Optional || b.Optional
+			b.Nullable = a.Nullable || b.Nullable
+			return b
+		}
+		if isUnknownElement(b, a) {
+			a.Optional = a.Optional || b.Optional
+			a.Nullable = a.Nullable || b.Nullable
+			return a
+		}
+	}
+
+	merged := a
+	merged.Optional = a.Optional || b.Optional
+	merged.Nullable = a.Nullable || b.Nullable
+
+	if a.IsArray != b.IsArray || a.IsComplex != b.IsComplex {
+		// 배열/단일, 객체/기본 타입 충돌
+		merged.Type = TypeObject
+		merged.Children = nil
+		merged.IsArray = false
+		merged.IsComplex = false
+		merged.Format = ""
+		merged.Enum = nil
+		return merged
+	}
+
+	if !a.IsComplex {
+		merged.Type = WidenType(a.Type, b.Type)
+		merged.Format = mergeFormat(a.Format, b.Format)
+		merged.Enum = mergeEnum(a.Enum, b.Enum)
+		return merged
+	}
+
+	merged.Children = mergeChildren(a.Children, b.Children)
+	return merged
+}
+
+// 자식 필드 합집합 (a의 순서 유지, b에만 있는 키는 뒤에 추가)
+func mergeChildren(a, b []Field) []Field {
+	result := make([]Field, 0, len(a)+len(b))
+	for _, ac := range a {
+		if bc, ok := findField(b, ac.Name); ok {
+			result = append(result, MergeFields(ac, bc))
+		} else {
+			ac.Optional = true
+			result = append(result, ac)
+		}
+	}
+	for _, bc := range b {
+		if _, ok := findField(a, bc.Name); !ok {
+			bc.Optional = true
+			result = append(result, bc)
+		}
+	}
+	return result
+}
+
+func findField(fields []Field, name string) (Field, bool) {
+	for _, f := range fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// 타입을 알 수 없는 단순 값 (null, 빈 배열의 원소 등)
+func isUnknownField(f Field) bool {
+	return !f.IsComplex && !f.IsArray && f.Type == TypeObject
+}
+
+// 배열 f의 원소 타입을 알 수 없음 (빈 배열 등), 중첩 깊이가 other와 다르면 1차원 빈 배열만 해당
+func isUnknownElement(f, other Field) bool {
+	if f.IsComplex {
+		return false
+	}
+	prefix, base := splitArrayPrefix(f.Type)
+	if base != TypeObject {
+		return false
+	}
+	otherPrefix, _ := splitArrayPrefix(other.Type)
+	return prefix == "" || prefix == otherPrefix
+}
+
+// 두 기본 타입을 모두 담을 수 있는 타입 (중첩 배열 "[]int"도 처리)
+func WidenType(a, b string) string {
+	if a == b {
+		return a
+	}
+	aPrefix, aBase := splitArrayPrefix(a)
+	bPrefix, bBase := splitArrayPrefix(b)
+	if aPrefix != bPrefix {
+		return TypeObject
+	}
+	if aBase == TypeObject {
+		return b
+	}
+	if bBase == TypeObject {
+		return a
+	}
+	aRank, bRank := numericRank(aBase), numericRank(bBase)
+	if aRank > 0 && bRank > 0 {
+		if aRank > bRank {
+			return a
+		}
+		return b
+	}
+	return TypeObject
+}
+
+// "[][]int" → "[][]", "int"
+func splitArrayPrefix(t string) (string, string) {
+	base := strings.TrimLeft(t, "[]")
+	return t[:len(t)-len(base)], base
+}
+
+// 숫자 타입 확장 순서 (숫자가 아니면 0)
+func numericRank(t string) int {
+	switch t {
+	case TypeInt:
+		return 1
+	case TypeLong:
+		return 2
+	case TypeFloat:
+		return 3
+	}
+	return 0
+}
+
+// 허용 값 합집합 (한쪽이라도 제한이 없으면 제한 없음)
+func mergeEnum(a, b []string) []string {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	result := append([]string{}, a...)
+	for _, v := range b {
+		found := false
+		for _, r := range result {
+			if r == v {
+				found = true
+				break
+			}
+		}
+		if !found {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,50 @@
+package models
+
+import "testing"
+
+func TestMergeFields(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string // 샘플 JSON
+		path string
+		want fieldShape
+	}{
+		{"int + long", `{"v":1}`, `{"v":3000000000}`, "V", fieldShape{Type: TypeLong, WireName: "v"}},
+		{"long + float", `{"v":3000000000}`, `{"v":1.5}`, "V", fieldShape{Type: TypeFloat, WireName: "v"}},
+		{"string + int", `{"v":"a"}`, `{"v":1}`, "V", fieldShape{Type: TypeObject, WireName: "v"}},
+		{"배열 + 단일 값", `{"v":[1]}`, `{"v":1}`, "V", fieldShape{Type: TypeObject, WireName: "v"}},
+		{"한쪽에만 있는 키", `{"v":1}`, `{"w":1}`, "W", fieldShape{Type: TypeInt, WireName: "w", Optional: true}},
+		{"null + int", `{"v":null}`, `{"v":1}`, "V", fieldShape{Type: TypeInt, WireName: "v", Nullable: true}},
+		{"int + null", `{"v":1}`, `{"v":null}`, "V", fieldShape{Type: TypeInt, WireName: "v", Nullable: true}},
+		{"빈 배열 + 객체 배열", `{"v":[]}`, `{"v":[{"id":1}]}`, "V", fieldShape{Type: "V", WireName: "v", IsArray: true, IsComplex: true}},
+		{"객체 배열 + 빈 배열", `{"v":[{"id":1}]}`, `{"v":[]}`, "V/Id", fieldShape{Type: TypeInt, WireName: "id"}},
+		{"객체 키 합집합", `{"v":{"a":1}}`, `{"v":{"b":"x"}}`, "V/B", fieldShape{Type: TypeString, WireName: "b", Optional: true}},
+		{"배열 원소 확장", `{"v":[1]}`, `{"v":[2.5]}`, "V", fieldShape{Type: TypeFloat, WireName: "v", IsArray: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged := MergeFields(mustParseJSON(t, tt.a, "root"), mustParseJSON(t, tt.b, "root"))
+			if got := shapeOf(fieldAt(t, merged, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWidenType(t *testing.T) {
+	tests := []struct {
+		a, b, want string
+	}{
+		{TypeInt, TypeInt, TypeInt},
+		{TypeInt, TypeLong, TypeLong},
+		{TypeLong, TypeFloat, TypeFloat},
+		{TypeBool, TypeInt, TypeObject},
+		{"[]" + TypeInt, "[]" + TypeFloat, "[]" + TypeFloat},
+		{"[]" + TypeInt, TypeInt, TypeObject},
+	}
+	for _, tt := range tests {
+		if got := WidenType(tt.a, tt.b); got != tt.want {
+			t.Errorf("WidenType(%q, %q) = %q, want %q", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -1,38 +1,94 @@
 package models
 
 import (
-	"encoding/xml"
-	"strings"
+	"encoding/json"
+	"math"
+	"sort"
+)
+
+// 언어 중립 기본 타입 (각 generator가 언어별 타입으로 변환)
+const (
+	TypeString = "string"
+	TypeBool   = "bool"
+	TypeInt    = "int"    // 32비트 정수
+	TypeLong   = "long"   // 64비트 정수
+	TypeFloat  = "float"  // 배정밀도 실수
+	TypeObject = "object" // 알 수 없는 타입
+)
+
+// XML 노드 종류
+type XMLKind int
+
+const (
+	XMLElement   XMLKind = iota // 자식 요소 (기본값)
+	XMLAttribute                // 속성 (<Department id="101">)
+	XMLCharData                 // 요소의 텍스트 내용
+	XMLInnerXML                 // 자식 요소가 섞인 텍스트 (원본 XML 그대로)
 )
 
 // 데이터 구조 트리
 type Field struct {
 	Name      string
+	WireName  string // 원본 JSON 키 / XML 요소·속성 이름 (직렬화 태그에 그대로 사용)
 	Type      string
 	Children  []Field
 	IsArray   bool
 	IsComplex bool
+	Optional  bool     // 일부 샘플/배열 원소에만 있는 키
+	Nullable  bool     // null 값이 관찰된 필드
+	Format    string   // 문자열 값 형식 (date-time, email 등, 모든 샘플이 같을 때만)
+	Enum      []string // 허용 값 목록 (JSON Schema enum, 값마다 JSON 표현 예: `"a"`, `1`, `null`)
+
+	XMLKind      XMLKind
+	XMLItemName  string // 래퍼 배열의 아이템 요소 이름 (<Employees><Employee/>)
+	XMLUnwrapped bool   // 래퍼 없이 반복되는 XML 요소 (<Tag/><Tag/>)
+
+	Bundle bool // 루트 타입 묶음 (OpenAPI 스키마 모음): 자신은 생성하지 않고 자식마다 루트 타입으로 생성
 }
 
 // JSON → Field 트리 (재귀)
+// map은 순서가 없으므로 키 이름순, OrderedObject는 원본 순서 유지
 func ParseJSONToFields(data interface{}, name string) Field {
 	switch v := data.(type) {
+	case OrderedObject:
+		children := []Field{}
+		for _, entry := range v {
+			children = append(children, ParseJSONToFields(entry.Value, entry.Key))
+		}
+		return Field{
+			Name:      ToExported(name),
+			WireName:  name,
+			Type:      ToTypeName(name),
+			Children:  children,
+			IsArray:   false,
+			IsComplex: true,
+		}
 	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
 		children := []Field{}
-		for key, value := range v {
-			childField := ParseJSONToFields(value, key)
+		for _, key := range keys {
+			childField := ParseJSONToFields(v[key], key)
 			children = append(children, childField)
 		}
 		return Field{
 			Name:      ToExported(name),
-			Type:      ToExported(name),
+			WireName:  name,
+			Type:      ToTypeName(name),
 			Children:  children,
 			IsArray:   false,
 			IsComplex: true,
 		}
 	case []interface{}:
 		if len(v) > 0 {
+			// 모든 원소의 구조를 병합해 원소 타입 추론
 			childField := ParseJSONToFields(v[0], name)
+			for _, item := range v[1:] {
+				childField = MergeFields(childField, ParseJSONToFields(item, name))
+			}
 			// 이 때 childField.IsArray에 따라 중첩배열을 판별
 			elemType := childField.Type
 			isNestedArray := childField.IsArray
@@ -40,54 +96,81 @@ func ParseJSONToFields(data interface{}, name string) Field {
 			if isNestedArray {
 				return Field{
 					Name:      ToExported(name),
+					WireName:  name,
 					Type:      "[]" + elemType, // 2차원 배열
 					Children:  childField.Children,
 					IsArray:   true,
 					IsComplex: childField.IsComplex,
+					Format:    childField.Format,
 				}
 			}
 			return Field{
 				Name:      ToExported(name),
+				WireName:  name,
 				Type:      elemType, // 1차원
 				Children:  childField.Children,
 				IsArray:   true,
 				IsComplex: childField.IsComplex,
+				Format:    childField.Format,
 			}
 		} else {
 			return Field{
 				Name:      ToExported(name),
-				Type:      "interface{}", // unknown type for empty array
+				WireName:  name,
+				Type:      TypeObject, // unknown type for empty array
 				Children:  nil,
 				IsArray:   true,
 				IsComplex: false,
 			}
 		}
 	case string:
-		return Field{Name: ToExported(name), Type: "string"}
+		return Field{Name: ToExported(name), WireName: name, Type: TypeString, Format: DetectFormat(v)}
+	case json.Number:
+		return Field{Name: ToExported(name), WireName: name, Type: numberType(v)}
 	case float64:
-		return Field{Name: ToExported(name), Type: "int"}
+		// json.Unmarshal로 읽은 값 (UseNumber 미사용)
+		if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+			return Field{Name: ToExported(name), WireName: name, Type: integerType(int64(v))}
+		}
+		return Field{Name: ToExported(name), WireName: name, Type: TypeFloat}
 	case bool:
-		return Field{Name: ToExported(name), Type: "bool"}
+		return Field{Name: ToExported(name), WireName: name, Type: TypeBool}
+	case nil:
+		// 타입은 다른 샘플과 병합할 때 결정
+		return Field{Name: ToExported(name), WireName: name, Type: TypeObject, Nullable: true}
 	default:
-		return Field{Name: ToExported(name), Type: "object"}
+		return Field{Name: ToExported(name), WireName: name, Type: TypeObject}
 	}
 }
 
-// XML → Field 트리 (간단 샘플)
-// 실제로는 xml.Decoder로 Element별 재귀 파싱/배열/속성 처리 추가 필요
-func ParseXMLToFields(data []byte, name string) Field {
-	var m map[string]interface{}
-	if err := xml.Unmarshal(data, (*map[string]interface{})(&m)); err == nil && len(m) > 0 {
-		return ParseJSONToFields(m, name)
+// 숫자 리터럴 → 정수(32/64비트) 또는 실수
+func numberType(n json.Number) string {
+	if i, err := n.Int64(); err == nil {
+		return integerType(i)
 	}
-	// 실제 구현은 xml.Decoder로 태그 구조 → map 변환 로직 필요
-	return Field{Name: ToExported(name), Type: "object"}
+	return TypeFloat
 }
 
-// 첫글자 대문자 (Go/C#/Python 네이밍)
-func ToExported(name string) string {
-	if name == "" {
-		return ""
+// 32비트 범위를 넘으면 64비트 정수로 확장
+func integerType(i int64) string {
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return TypeLong
+	}
+	return TypeInt
+}
+
+// 자식 필드를 이름순으로 정렬 (재귀, 원본 순서 대신 알파벳순 출력용)
+func SortFields(f Field) Field {
+	if len(f.Children) == 0 {
+		return f
+	}
+	children := make([]Field, len(f.Children))
+	for i, c := range f.Children {
+		children[i] = SortFields(c)
 	}
-	return strings.ToUpper(name[:1]) + name[1:]
+	sort.SliceStable(children, func(i, j int) bool {
+		return children[i].Name < children[j].Name
+	})
+	f.Children = children
+	return f
 }
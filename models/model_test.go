@@ -0,0 +1,63 @@
+package models
+
+import (
+	"strings"
+	"testing"
+)
+
+// 테스트에서 비교할 Field 속성 (Children/Format/Enum 제외)
+type fieldShape struct {
+	Type         string
+	WireName     string
+	IsArray      bool
+	IsComplex    bool
+	Optional     bool
+	Nullable     bool
+	XMLKind      XMLKind
+	XMLItemName  string
+	XMLUnwrapped bool
+}
+
+func shapeOf(f Field) fieldShape {
+	return fieldShape{
+		Type:         f.Type,
+		WireName:     f.WireName,
+		IsArray:      f.IsArray,
+		IsComplex:    f.IsComplex,
+		Optional:     f.Optional,
+		Nullable:     f.Nullable,
+		XMLKind:      f.XMLKind,
+		XMLItemName:  f.XMLItemName,
+		XMLUnwrapped: f.XMLUnwrapped,
+	}
+}
+
+// 필드명 경로로 하위 필드 조회 ("Departments/Employees")
+func fieldAt(t *testing.T, f Field, path string) Field {
+	t.Helper()
+	if path == "" {
+		return f
+	}
+	for _, name := range strings.Split(path, "/") {
+		found := false
+		for _, c := range f.Children {
+			if c.Name == name {
+				f, found = c, true
+				break
+			}
+		}
+		if !found {
+			t.Fatalf("%s: %s 필드가 없습니다", path, name)
+		}
+	}
+	return f
+}
+
+func mustParseJSON(t *testing.T, data, name string) Field {
+	t.Helper()
+	f, err := ParseJSON([]byte(data), name)
+	if err != nil {
+		t.Fatalf("ParseJSON: %v", err)
+	}
+	return f
+}
@@ -0,0 +1,40 @@
+package models
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 첫글자 대문자 (Go/C#/Python 네이밍, 멀티바이트 문자 안전)
+func ToExported(name string) string {
+	if name == "" {
+		return ""
+	}
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
+
+// 키/요소 이름 → 언어 중립 타입명 ("postal-code" → "PostalCode", "2fa" → "_2fa")
+// 언어별 예약어 처리는 각 generator에서 수행
+func ToTypeName(name string) string {
+	var sb strings.Builder
+	for _, w := range SplitWords(name) {
+		sb.WriteString(ToExported(w))
+	}
+	t := sb.String()
+	if t == "" {
+		return "Type"
+	}
+	if r, _ := utf8.DecodeRuneInString(t); unicode.IsDigit(r) {
+		return "_" + t
+	}
+	return t
+}
+
+// 문자/숫자가 아닌 문자(-, 공백, _, . 등)를 기준으로 단어 분리
+func SplitWords(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
@@ -0,0 +1,62 @@
+package models
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OpenAPI 3 문서(JSON/YAML) → components/schemas의 객체 스키마를 모두 자식으로 가진 루트 묶음(Bundle) Field
+// 루트 자체는 생성하지 않고 스키마마다 타입과 입출력 함수 생성, $ref는 문서 전체 기준으로 해석해 스키마 이름을 타입명으로 공유
+// 객체가 아닌 스키마(enum 문자열 등)는 참조하는 필드에 펼쳐지므로 skipped로 돌려줌
+func ParseOpenAPI(data []byte, name string) (root Field, skipped []string, err error) {
+	var doc interface{}
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '{' {
+		doc, err = DecodeOrderedJSON(data)
+	} else {
+		doc, err = DecodeOrderedYAML(data)
+	}
+	if err != nil {
+		return Field{}, nil, err
+	}
+
+	obj, _ := doc.(OrderedObject)
+	version, _ := schemaValue(obj, "openapi").(string)
+	if !strings.HasPrefix(version, "3.") {
+		return Field{}, nil, errors.New("OpenAPI 3 문서가 아닙니다 (openapi: 3.x 필요)")
+	}
+	components, _ := schemaValue(obj, "components").(OrderedObject)
+	schemas, _ := schemaValue(components, "schemas").(OrderedObject)
+	if len(schemas) == 0 {
+		return Field{}, nil, errors.New("components/schemas에 스키마가 없습니다")
+	}
+
+	// 같은 $ref는 어디서 참조해도 같은 구조가 되도록 해석 결과 재사용
+	p := &schemaParser{doc: doc, refs: map[string]Field{}}
+	children := []Field{}
+	for _, entry := range schemas {
+		ref := "#/components/schemas/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(entry.Key)
+		field := p.ref(ref, entry.Key)
+		if p.err != nil {
+			return Field{}, nil, fmt.Errorf("%s: %w", entry.Key, p.err)
+		}
+		if !field.IsComplex || field.IsArray {
+			skipped = append(skipped, entry.Key)
+			continue
+		}
+		field.Optional = true
+		children = append(children, field)
+	}
+
+	rootType := ToTypeName(name)
+	for _, c := range children {
+		if c.Type == rootType {
+			// 루트 타입은 생성하지 않지만 타입 정리(DedupTypes)에서 스키마 이름과 겹치지 않게 구분
+			rootType += "Components"
+			break
+		}
+	}
+	root = Field{Name: rootType, WireName: name, Type: rootType, Children: children, IsComplex: true, Bundle: true}
+	return root, skipped, nil
+}
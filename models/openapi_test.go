@@ -0,0 +1,99 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+)
+
+const petOpenAPI = `
+openapi: 3.0.3
+info: {title: pets, version: "1"}
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [id]
+      properties:
+        id: {type: integer, format: int64}
+        status: {$ref: '#/components/schemas/Status'}
+        owner: {$ref: '#/components/schemas/Owner'}
+        tags:
+          type: array
+          items: {$ref: '#/components/schemas/Tag'}
+    Owner:
+      type: object
+      properties:
+        name: {type: string, nullable: true}
+    Tag:
+      type: object
+      properties:
+        label: {type: string}
+    Dog:
+      allOf:
+        - $ref: '#/components/schemas/Pet'
+        - type: object
+          required: [breed]
+          properties:
+            breed: {type: string}
+    Status:
+      type: string
+      enum: [available, sold]
+`
+
+func TestParseOpenAPI(t *testing.T) {
+	root, skipped, err := ParseOpenAPI([]byte(petOpenAPI), "pet")
+	if err != nil {
+		t.Fatalf("ParseOpenAPI: %v", err)
+	}
+	// 파일명이 스키마 이름(Pet)과 겹치면 루트(묶음) 타입명 구분, Name도 같은 이름
+	if root.Type != "PetComponents" || root.Name != root.Type || !root.Bundle {
+		t.Errorf("root = {Name: %s, Type: %s, Bundle: %t}, want PetComponents bundle", root.Name, root.Type, root.Bundle)
+	}
+	if want := []string{"Status"}; !reflect.DeepEqual(skipped, want) {
+		t.Errorf("skipped = %v, want %v", skipped, want)
+	}
+
+	tests := []struct {
+		path string
+		want fieldShape
+	}{
+		{"Pet", fieldShape{Type: "Pet", WireName: "Pet", IsComplex: true, Optional: true}},
+		{"Pet/Id", fieldShape{Type: TypeLong, WireName: "id"}},
+		// 객체가 아닌 스키마는 참조하는 필드에 펼침
+		{"Pet/Status", fieldShape{Type: TypeString, WireName: "status", Optional: true}},
+		// $ref는 스키마 이름을 타입명으로
+		{"Pet/Owner", fieldShape{Type: "Owner", WireName: "owner", IsComplex: true, Optional: true}},
+		{"Pet/Tags", fieldShape{Type: "Tag", WireName: "tags", IsArray: true, IsComplex: true, Optional: true}},
+		{"Owner/Name", fieldShape{Type: TypeString, WireName: "name", Optional: true, Nullable: true}},
+		// allOf: 참조한 스키마 속성 + 추가 속성
+		{"Dog", fieldShape{Type: "Dog", WireName: "Dog", IsComplex: true, Optional: true}},
+		{"Dog/Id", fieldShape{Type: TypeLong, WireName: "id"}},
+		{"Dog/Breed", fieldShape{Type: TypeString, WireName: "breed"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseOpenAPIErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+	}{
+		{"Swagger 2", "swagger: '2.0'\ndefinitions: {}\n"},
+		{"스키마 없음", "openapi: 3.0.0\ncomponents: {}\n"},
+		{"잘못된 $ref", "openapi: 3.0.0\ncomponents:\n  schemas:\n    A:\n      type: object\n      properties:\n        b: {$ref: '#/components/schemas/Missing'}\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := ParseOpenAPI([]byte(tt.doc), "api"); err == nil {
+				t.Error("오류가 나야 합니다")
+			}
+		})
+	}
+}
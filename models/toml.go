@@ -0,0 +1,97 @@
+package models
+
+import (
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// TOML 샘플 → Field 트리 (테이블은 중첩 타입, 테이블 배열은 객체 배열)
+func ParseTOML(data []byte, name string) (Field, error) {
+	var raw map[string]interface{}
+	md, err := toml.Decode(string(data), &raw)
+	if err != nil {
+		return Field{}, err
+	}
+	// 디코딩 결과는 map이므로 MetaData의 키 등장 순서로 원본 순서 복원
+	// 테이블 배열 원소는 경로에 인덱스가 없어 같은 순서 목록을 공유
+	order := map[string][]string{}
+	seen := map[string]bool{}
+	for _, key := range md.Keys() {
+		path := strings.Join(key, "\x00")
+		if seen[path] {
+			continue
+		}
+		seen[path] = true
+		parent := strings.Join(key[:len(key)-1], "\x00")
+		order[parent] = append(order[parent], key[len(key)-1])
+	}
+	return ParseJSONToFields(tomlValue(raw, nil, order), name), nil
+}
+
+// TOML 값 → DecodeOrderedJSON과 같은 표현 (날짜/시각은 문자열)
+func tomlValue(v interface{}, path []string, order map[string][]string) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return tomlTable(v, path, order)
+	case []map[string]interface{}:
+		arr := make([]interface{}, len(v))
+		for i, t := range v {
+			arr[i] = tomlTable(t, path, order)
+		}
+		return arr
+	case []interface{}:
+		arr := make([]interface{}, len(v))
+		for i, item := range v {
+			arr[i] = tomlValue(item, path, order)
+		}
+		return arr
+	case int64:
+		return json.Number(strconv.FormatInt(v, 10))
+	case float64:
+		return floatNumber(v)
+	case time.Time:
+		// 시간대 없는 값은 디코더가 이름 붙인 Location으로 구분 (날짜만 있으면 date 형식)
+		switch v.Location().String() {
+		case "date-local":
+			return v.Format("2006-01-02")
+		case "datetime-local":
+			return v.Format("2006-01-02T15:04:05.999999999")
+		case "time-local":
+			return v.Format("15:04:05.999999999")
+		}
+		return v.Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+// 테이블 → OrderedObject (등장 순서 목록에 없는 키(배열 안 인라인 테이블 등)는 이름순으로 뒤에)
+func tomlTable(table map[string]interface{}, path []string, order map[string][]string) OrderedObject {
+	var keys []string
+	listed := map[string]bool{}
+	for _, key := range order[strings.Join(path, "\x00")] {
+		if _, ok := table[key]; ok {
+			keys = append(keys, key)
+			listed[key] = true
+		}
+	}
+	var rest []string
+	for key := range table {
+		if !listed[key] {
+			rest = append(rest, key)
+		}
+	}
+	sort.Strings(rest)
+	keys = append(keys, rest...)
+
+	obj := OrderedObject{}
+	for _, key := range keys {
+		childPath := append(append([]string{}, path...), key)
+		obj = obj.set(key, tomlValue(table[key], childPath, order))
+	}
+	return obj
+}
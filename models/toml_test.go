@@ -0,0 +1,86 @@
+package models
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestParseTOMLScalars(t *testing.T) {
+	tests := []struct {
+		value  string
+		want   string
+		format string
+	}{
+		{"true", TypeBool, ""},
+		{"42", TypeInt, ""},
+		{"3000000000", TypeLong, ""},
+		{"0x1F", TypeInt, ""},
+		{"1.5", TypeFloat, ""},
+		// 정수로 보이는 실수도 실수
+		{"1.0", TypeFloat, ""},
+		{"1e3", TypeFloat, ""},
+		{"inf", TypeFloat, ""},
+		{`"04524"`, TypeString, ""},
+		{"1979-05-27", TypeString, "date"},
+		{"1979-05-27T07:32:00Z", TypeString, "date-time"},
+		{"1979-05-27T07:32:00-08:00", TypeString, "date-time"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			root, err := ParseTOML([]byte("v = "+tt.value+"\n"), "config")
+			if err != nil {
+				t.Fatalf("ParseTOML: %v", err)
+			}
+			v := fieldAt(t, root, "V")
+			if v.Type != tt.want || v.Format != tt.format {
+				t.Errorf("got %s (%q), want %s (%q)", v.Type, v.Format, tt.want, tt.format)
+			}
+		})
+	}
+}
+
+func TestParseTOMLTables(t *testing.T) {
+	root, err := ParseTOML([]byte(`title = "svc"
+zeta = 1
+alpha = 2
+
+[server]
+port = 8080
+
+[[backends]]
+host = "a"
+
+[[backends]]
+host = "b"
+weight = 2
+`), "service")
+	if err != nil {
+		t.Fatalf("ParseTOML: %v", err)
+	}
+
+	// 키 순서는 원본 순서
+	var names []string
+	for _, c := range root.Children {
+		names = append(names, c.WireName)
+	}
+	if got, want := fmt.Sprint(names), "[title zeta alpha server backends]"; got != want {
+		t.Errorf("키 순서 = %s, want %s", got, want)
+	}
+
+	tests := []struct {
+		path string
+		want fieldShape
+	}{
+		{"Server/Port", fieldShape{Type: TypeInt, WireName: "port"}},
+		{"Backends", fieldShape{Type: "Backends", WireName: "backends", IsArray: true, IsComplex: true}},
+		// 일부 테이블에만 있는 키는 optional
+		{"Backends/Weight", fieldShape{Type: TypeInt, WireName: "weight", Optional: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
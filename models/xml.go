@@ -0,0 +1,210 @@
+package models
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strings"
+)
+
+// XML 요소 구조 (같은 부모 아래 같은 이름의 요소는 하나로 병합)
+type xmlShape struct {
+	name      string
+	attrs     []string
+	attrCount map[string]int // 속성별 등장한 인스턴스 수
+	children  []*xmlShape
+	repeated  bool // 한 부모 안에서 2번 이상 등장하면 배열
+	hasText   bool // 공백이 아닌 텍스트 내용 포함 여부
+	count     int  // 이 요소의 인스턴스 수
+	presentIn int  // 이 요소를 포함한 부모 인스턴스 수
+}
+
+// 이름으로 자식 구조 조회 (없으면 등장 순서대로 추가)
+func (s *xmlShape) child(name string) *xmlShape {
+	for _, c := range s.children {
+		if c.name == name {
+			return c
+		}
+	}
+	c := &xmlShape{name: name}
+	s.children = append(s.children, c)
+	return c
+}
+
+func (s *xmlShape) addAttr(name string) {
+	if s.attrCount == nil {
+		s.attrCount = map[string]int{}
+	}
+	if s.attrCount[name] == 0 {
+		s.attrs = append(s.attrs, name)
+	}
+	s.attrCount[name]++
+}
+
+// 속성/자식 요소가 없는 요소는 단순 값으로 취급
+func (s *xmlShape) isLeaf() bool {
+	return len(s.attrs) == 0 && len(s.children) == 0
+}
+
+// 파싱 중인 요소 인스턴스
+type xmlFrame struct {
+	shape  *xmlShape
+	counts map[string]int
+	text   strings.Builder
+}
+
+// XML → Field 트리
+// xml.Decoder로 토큰을 스트리밍하면서 요소 구조를 병합한 뒤 Field로 변환
+//   - 같은 이름의 형제 요소가 반복되면 배열
+//   - 속성은 XMLAttribute 종류의 자식 필드로 추가
+//   - 일부 인스턴스에만 있는 요소/속성은 Optional
+//   - 텍스트 내용은 Value 필드(chardata, 자식 요소와 섞이면 innerxml)로 보존
+func ParseXMLToFields(data []byte, name string) (Field, error) {
+	dec := xml.NewDecoder(bytes.NewReader(data))
+
+	var root *xmlShape
+	var stack []*xmlFrame
+	for {
+		tok, err := dec.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Field{}, err
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			var shape *xmlShape
+			if len(stack) == 0 {
+				if root != nil {
+					return Field{}, errors.New("XML 루트 요소가 여러 개입니다")
+				}
+				root = &xmlShape{name: t.Name.Local}
+				shape = root
+			} else {
+				parent := stack[len(stack)-1]
+				shape = parent.shape.child(t.Name.Local)
+				parent.counts[t.Name.Local]++
+				if parent.counts[t.Name.Local] == 1 {
+					shape.presentIn++
+				} else {
+					shape.repeated = true
+				}
+			}
+			shape.count++
+			for _, a := range t.Attr {
+				// 네임스페이스 선언은 데이터가 아니므로 제외
+				if a.Name.Space == "xmlns" || a.Name.Local == "xmlns" {
+					continue
+				}
+				shape.addAttr(a.Name.Local)
+			}
+			stack = append(stack, &xmlFrame{shape: shape, counts: map[string]int{}})
+		case xml.CharData:
+			if len(stack) > 0 {
+				stack[len(stack)-1].text.Write(t)
+			}
+		case xml.EndElement:
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if strings.TrimSpace(top.text.String()) != "" {
+				top.shape.hasText = true
+			}
+		}
+	}
+
+	if root == nil {
+		return Field{}, errors.New("XML 루트 요소가 없습니다")
+	}
+	// 루트는 래퍼여도 배열로 바꾸지 않음 (루트 타입이 아이템 목록 필드를 가짐)
+	return xmlShapeToField(root, name, true), nil
+}
+
+// 병합된 요소 구조 → Field (재귀)
+func xmlShapeToField(s *xmlShape, name string, isRoot bool) Field {
+	if s.isLeaf() {
+		return Field{Name: ToExported(name), WireName: s.name, Type: TypeString}
+	}
+
+	// 래퍼 요소(<Employees><Employee/>...</Employees>)는 아이템 배열로 변환
+	if !isRoot && len(s.attrs) == 0 && !s.hasText && len(s.children) == 1 && s.children[0].repeated {
+		item := s.children[0]
+		arr := asArrayField(xmlShapeToField(item, item.name, false), name)
+		arr.WireName = s.name
+		arr.XMLItemName = item.name
+		return arr
+	}
+
+	children := []Field{}
+	for _, a := range s.attrs {
+		children = append(children, Field{
+			Name:     ToExported(a),
+			Type:     TypeString,
+			Optional: s.attrCount[a] < s.count,
+			WireName: a,
+			XMLKind:  XMLAttribute,
+		})
+	}
+	for _, c := range s.children {
+		childField := xmlShapeToField(c, c.name, false)
+		if c.repeated {
+			// 래퍼 없이 반복되는 요소 (<Tag/><Tag/>)
+			childField = asArrayField(childField, c.name)
+			childField.WireName = c.name
+			childField.XMLUnwrapped = true
+		}
+		// 일부 부모 인스턴스에만 있는 요소
+		childField.Optional = c.presentIn < s.count
+		children = append(children, childField)
+	}
+	if s.hasText {
+		kind := XMLCharData
+		if len(s.children) > 0 {
+			kind = XMLInnerXML
+		}
+		children = append(children, Field{Name: xmlTextFieldName(children), Type: TypeString, XMLKind: kind})
+	}
+
+	return Field{
+		Name:      ToExported(name),
+		Type:      ToTypeName(name),
+		Children:  children,
+		IsArray:   false,
+		IsComplex: true,
+		WireName:  s.name,
+	}
+}
+
+// 아이템 필드를 배열 필드로 감싸기 (이미 배열이면 2차원 배열)
+func asArrayField(item Field, name string) Field {
+	elemType := item.Type
+	if item.IsArray {
+		elemType = "[]" + elemType
+	}
+	return Field{
+		Name:      ToExported(name),
+		Type:      elemType,
+		Children:  item.Children,
+		IsArray:   true,
+		IsComplex: item.IsComplex,
+	}
+}
+
+// 텍스트 내용 필드명 (자식 요소/속성과 겹치지 않게)
+func xmlTextFieldName(children []Field) string {
+	for _, candidate := range []string{"Value", "Text"} {
+		taken := false
+		for _, c := range children {
+			if c.Name == candidate {
+				taken = true
+				break
+			}
+		}
+		if !taken {
+			return candidate
+		}
+	}
+	return "InnerText"
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"os"
+	"testing"
+)
+
+func TestParseXMLToFields(t *testing.T) {
+	company, err := os.ReadFile("../test.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mixed := []byte(`<note id="1">Hello <b>bold</b> world</note>`)
+	repeated := []byte(`<p><t>a</t><t>b</t><price cur="USD">9.5</price></p>`)
+	list := []byte(`<items><item>1</item><item>2</item></items>`)
+
+	tests := []struct {
+		name string
+		data []byte
+		path string
+		want fieldShape
+	}{
+		{"루트", company, "", fieldShape{Type: "Test", WireName: "Company", IsComplex: true}},
+		{"루트 속성", company, "Founded", fieldShape{Type: TypeString, WireName: "founded", XMLKind: XMLAttribute}},
+		{"래퍼 배열", company, "Departments", fieldShape{Type: "Department", WireName: "Departments", IsArray: true, IsComplex: true, XMLItemName: "Department"}},
+		{"원소 속성", company, "Departments/Id", fieldShape{Type: TypeString, WireName: "id", XMLKind: XMLAttribute}},
+		{"중첩 요소", company, "Departments/Manager/EmployeeID", fieldShape{Type: TypeString, WireName: "EmployeeID"}},
+		{"일부 원소에만 있는 배열", company, "Departments/Employees/Skills", fieldShape{Type: TypeString, WireName: "Skills", IsArray: true, Optional: true, XMLItemName: "Skill"}},
+		{"혼합 내용 텍스트", mixed, "Value", fieldShape{Type: TypeString, XMLKind: XMLInnerXML}},
+		{"혼합 내용 요소", mixed, "B", fieldShape{Type: TypeString, WireName: "b"}},
+		{"래퍼 없는 반복 요소", repeated, "T", fieldShape{Type: TypeString, WireName: "t", IsArray: true, XMLUnwrapped: true}},
+		{"속성 있는 텍스트 요소", repeated, "Price/Value", fieldShape{Type: TypeString, XMLKind: XMLCharData}},
+		{"반복 요소만 있는 루트는 배열로 접지 않음", list, "", fieldShape{Type: "Test", WireName: "items", IsComplex: true}},
+		{"루트 아래 반복 요소", list, "Item", fieldShape{Type: TypeString, WireName: "item", IsArray: true, XMLUnwrapped: true}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ParseXMLToFields(tt.data, "test")
+			if err != nil {
+				t.Fatalf("ParseXMLToFields: %v", err)
+			}
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,236 @@
+package models
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// YAML 샘플 → Field 트리 (여러 문서(---)면 각 문서를 샘플로 보고 병합)
+func ParseYAML(data []byte, name string) (Field, error) {
+	docs, err := DecodeYAMLDocuments(data)
+	if err != nil {
+		return Field{}, err
+	}
+	if len(docs) == 0 {
+		return Field{}, errors.New("YAML 문서가 없습니다")
+	}
+	field := ParseJSONToFields(docs[0], name)
+	for _, doc := range docs[1:] {
+		field = MergeFields(field, ParseJSONToFields(doc, name))
+	}
+	return field, nil
+}
+
+// YAML 스트림의 모든 문서 → 값 목록 (빈 문서 제외)
+func DecodeYAMLDocuments(data []byte) ([]interface{}, error) {
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	var docs []interface{}
+	for {
+		var doc yaml.Node
+		if err := dec.Decode(&doc); err == io.EOF {
+			return docs, nil
+		} else if err != nil {
+			return nil, err
+		}
+		if len(doc.Content) == 0 {
+			continue
+		}
+		v, err := newYAMLDecoder().value(&doc)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, v)
+	}
+}
+
+// YAML 문서 1개 → OrderedObject/[]interface{}/json.Number/string/bool/nil 값 (DecodeOrderedJSON과 같은 표현)
+func DecodeOrderedYAML(data []byte) (interface{}, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	if doc.Kind == 0 {
+		// 빈 문서
+		return nil, nil
+	}
+	return newYAMLDecoder().value(&doc)
+}
+
+// 별칭으로 펼칠 수 있는 최대 노드 수 (별칭 폭탄 방지, yaml.v3 디코더와 같은 취지)
+const maxYAMLAliasNodes = 1000000
+
+// 별칭 펼침 상태 (순환 감지용 펼치는 중인 앵커, 별칭으로 펼친 노드 수)
+type yamlDecoder struct {
+	expanding  map[*yaml.Node]bool
+	aliasNodes int
+}
+
+func newYAMLDecoder() *yamlDecoder {
+	return &yamlDecoder{expanding: map[*yaml.Node]bool{}}
+}
+
+// yaml.Node → 값 (매핑 키 순서 유지, 앵커/별칭은 참조 대상 값으로 펼침)
+func (d *yamlDecoder) value(node *yaml.Node) (interface{}, error) {
+	if len(d.expanding) > 0 {
+		d.aliasNodes++
+		if d.aliasNodes > maxYAMLAliasNodes {
+			return nil, fmt.Errorf("%d행: 별칭으로 펼친 노드가 너무 많습니다", node.Line)
+		}
+	}
+	switch node.Kind {
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return d.value(node.Content[0])
+	case yaml.AliasNode:
+		if d.expanding[node.Alias] {
+			return nil, fmt.Errorf("%d행: 순환 참조 별칭입니다: *%s", node.Line, node.Value)
+		}
+		d.expanding[node.Alias] = true
+		defer delete(d.expanding, node.Alias)
+		return d.value(node.Alias)
+	case yaml.MappingNode:
+		obj := OrderedObject{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Tag == "!!merge" {
+				// << : *base → 기준 매핑의 키를 가져옴 (직접 지정한 키가 우선)
+				if err := d.mergeKeys(&obj, value); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			v, err := d.value(value)
+			if err != nil {
+				return nil, err
+			}
+			obj = obj.set(key.Value, v)
+		}
+		return obj, nil
+	case yaml.SequenceNode:
+		arr := []interface{}{}
+		for _, item := range node.Content {
+			v, err := d.value(item)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, v)
+		}
+		return arr, nil
+	case yaml.ScalarNode:
+		return yamlScalarValue(node)
+	}
+	return nil, fmt.Errorf("%d행: 알 수 없는 YAML 노드", node.Line)
+}
+
+// 병합 키(<<)의 대상 매핑(또는 매핑 목록)을 obj에 없는 키만 추가
+func (d *yamlDecoder) mergeKeys(obj *OrderedObject, node *yaml.Node) error {
+	v, err := d.value(node)
+	if err != nil {
+		return err
+	}
+	sources := []interface{}{v}
+	if list, ok := v.([]interface{}); ok {
+		sources = list
+	}
+	for _, src := range sources {
+		m, ok := src.(OrderedObject)
+		if !ok {
+			return fmt.Errorf("%d행: 병합 키(<<)의 값은 매핑이어야 합니다", node.Line)
+		}
+		for _, entry := range m {
+			if _, exists := obj.Get(entry.Key); !exists {
+				*obj = append(*obj, entry)
+			}
+		}
+	}
+	return nil
+}
+
+// 스칼라 → 값 (태그로 타입 결정, 숫자는 json.Number)
+func yamlScalarValue(node *yaml.Node) (interface{}, error) {
+	switch node.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "!!int":
+		var i int64
+		if err := node.Decode(&i); err != nil {
+			// 64비트를 넘는 정수는 실수로
+			return json.Number(node.Value), nil
+		}
+		return json.Number(strconv.FormatInt(i, 10)), nil
+	case "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return nil, err
+		}
+		return floatNumber(f), nil
+	case "!!timestamp":
+		if ts, ok := yamlTimestamp(node.Value); ok {
+			return ts, nil
+		}
+		return nil, fmt.Errorf("%d행: 잘못된 timestamp 값: %s", node.Line, node.Value)
+	}
+	if node.Style == 0 {
+		// 따옴표 없는 YAML 1.1 timestamp ("2001-12-14 21:59:43.10 -5" 등)
+		if ts, ok := yamlTimestamp(node.Value); ok {
+			return ts, nil
+		}
+	}
+	return node.Value, nil
+}
+
+var yamlTimestampPattern = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})(?:(?:[Tt]|[ \t]+)(\d{1,2}):(\d{2}):(\d{2})(\.\d*)?[ \t]*(Z|[-+]\d{1,2}(?::?\d{2})?)?)?$`)
+
+// YAML timestamp → 날짜만 있으면 "2006-01-02"(date), 시각이 있으면 RFC 3339 문자열(date-time)
+// 시간대가 없으면 UTC
+func yamlTimestamp(s string) (string, bool) {
+	m := yamlTimestampPattern.FindStringSubmatch(s)
+	if m == nil {
+		return "", false
+	}
+	num := func(v string) int {
+		n, _ := strconv.Atoi(v)
+		return n
+	}
+	if m[4] == "" {
+		t := time.Date(num(m[1]), time.Month(num(m[2])), num(m[3]), 0, 0, 0, 0, time.UTC)
+		return t.Format("2006-01-02"), t.Day() == num(m[3])
+	}
+	nsec := 0
+	if frac := strings.TrimPrefix(m[7], "."); frac != "" {
+		nsec = num((frac + "000000000")[:9])
+	}
+	loc := time.UTC
+	if zone := m[8]; zone != "" && zone != "Z" {
+		hours, minutes := zone[1:], "0"
+		if i := strings.IndexAny(hours, ":"); i >= 0 {
+			hours, minutes = hours[:i], hours[i+1:]
+		} else if len(hours) > 2 {
+			hours, minutes = hours[:len(hours)-2], hours[len(hours)-2:]
+		}
+		offset := num(hours)*3600 + num(minutes)*60
+		if zone[0] == '-' {
+			offset = -offset
+		}
+		loc = time.FixedZone("", offset)
+	}
+	t := time.Date(num(m[1]), time.Month(num(m[2])), num(m[3]), num(m[4]), num(m[5]), num(m[6]), nsec, loc)
+	return t.Format(time.RFC3339Nano), t.Day() == num(m[3])
+}
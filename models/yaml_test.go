@@ -0,0 +1,65 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseYAMLAliases(t *testing.T) {
+	// 별칭 1개가 9개씩 9단계로 펼쳐지는 문서 (9^9개)
+	names := "abcdefghi"
+	var bomb strings.Builder
+	bomb.WriteString("a: &a [x, x, x, x, x, x, x, x, x]\n")
+	for i := 1; i < len(names); i++ {
+		refs := strings.TrimSuffix(strings.Repeat("*"+names[i-1:i]+", ", 9), ", ")
+		fmt.Fprintf(&bomb, "%c: &%c [%s]\n", names[i], names[i], refs)
+	}
+
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr bool
+		path    string
+		want    fieldShape
+	}{
+		{
+			name: "앵커/별칭",
+			yaml: "base: &base {host: a, port: 1}\ncopy: *base\n",
+			path: "Copy/Port",
+			want: fieldShape{Type: TypeInt, WireName: "port"},
+		},
+		{
+			name: "병합 키",
+			yaml: "base: &base {host: a}\nprod:\n  <<: *base\n  port: 2\n",
+			path: "Prod/Host",
+			want: fieldShape{Type: TypeString, WireName: "host"},
+		},
+		{
+			name: "같은 별칭 여러 번",
+			yaml: "x: &x {v: 1}\nlist: [*x, *x, *x]\n",
+			path: "List",
+			want: fieldShape{Type: "List", WireName: "list", IsArray: true, IsComplex: true},
+		},
+		{name: "자기 자신을 참조하는 별칭", yaml: "a: &a [*a]\n", wantErr: true},
+		{name: "서로 참조하는 별칭", yaml: "a: &a {b: &b {a: *a}}\n", wantErr: true},
+		{name: "별칭 폭탄", yaml: bomb.String(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root, err := ParseYAML([]byte(tt.yaml), "root")
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("오류가 나야 합니다")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseYAML: %v", err)
+			}
+			if got := shapeOf(fieldAt(t, root, tt.path)); got != tt.want {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}